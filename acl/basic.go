@@ -0,0 +1,230 @@
+package acl
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// BasicACL represents basic ACL of the NeoFS container: 32-bit mask of the
+// access rules.
+//
+// Mask consists of 4-bit groups of the object operations starting from the
+// least significant bits in the order: OperationGet, OperationHead,
+// OperationPut, OperationDelete, OperationSearch, OperationRange,
+// OperationRangeHash. Each group consists of the bits (from the least
+// significant one):
+//   - bearer token rules are allowed;
+//   - RoleOthers access is allowed;
+//   - RoleSystem access is allowed;
+//   - RoleUser (container owner) access is allowed.
+//
+// The following two bits are the final (F, bit 28) and the sticky (X, bit 29)
+// flags. The rest bits are reserved.
+//
+// Container's basic ACL is transmitted as a plain uint32 number, so the
+// conversion is direct.
+type BasicACL uint32
+
+const (
+	basicACLBitsPerOp = 4
+
+	basicACLBitBearer = 0
+	basicACLBitFinal  = 28
+	basicACLBitSticky = 29
+)
+
+// Well-known basic ACL presets.
+const (
+	// BasicACLPrivate allows all operations to the container owner only.
+	// Extended ACL is disabled.
+	BasicACLPrivate BasicACL = 0x1C8C8CCC
+
+	// BasicACLPrivateExtended is BasicACLPrivate with extended ACL enabled.
+	BasicACLPrivateExtended BasicACL = 0x0C8C8CCC
+
+	// BasicACLPublicRO allows all operations to the container owner and
+	// reading operations to others. Extended ACL is disabled.
+	BasicACLPublicRO BasicACL = 0x1FBF8CFF
+
+	// BasicACLPublicROExtended is BasicACLPublicRO with extended ACL enabled.
+	BasicACLPublicROExtended BasicACL = 0x0FBF8CFF
+
+	// BasicACLPublicRW allows all operations to everyone. Extended ACL is
+	// disabled.
+	BasicACLPublicRW BasicACL = 0x1FBFBFFF
+
+	// BasicACLPublicRWExtended is BasicACLPublicRW with extended ACL enabled.
+	BasicACLPublicRWExtended BasicACL = 0x0FBFBFFF
+
+	// BasicACLPublicAppend allows all operations to the container owner and
+	// reading and writing (but not removal) to others. Extended ACL is disabled.
+	BasicACLPublicAppend BasicACL = 0x1FBF9FFF
+
+	// BasicACLPublicAppendExtended is BasicACLPublicAppend with extended ACL
+	// enabled.
+	BasicACLPublicAppendExtended BasicACL = 0x0FBF9FFF
+)
+
+var basicACLPresets = []struct {
+	name string
+	val  BasicACL
+}{
+	{"private", BasicACLPrivate},
+	{"eacl-private", BasicACLPrivateExtended},
+	{"public-read", BasicACLPublicRO},
+	{"eacl-public-read", BasicACLPublicROExtended},
+	{"public-read-write", BasicACLPublicRW},
+	{"eacl-public-read-write", BasicACLPublicRWExtended},
+	{"public-append", BasicACLPublicAppend},
+	{"eacl-public-append", BasicACLPublicAppendExtended},
+}
+
+// basicACLOpBit returns position of the operation group bit. Returns false if the
+// operation is not an object one.
+func basicACLOpBit(op Operation, n uint8) (uint8, bool) {
+	if op < OperationGet || op > OperationRangeHash {
+		return 0, false
+	}
+
+	return uint8(op-OperationGet)*basicACLBitsPerOp + n, true
+}
+
+// basicACLRoleBit returns position of the role bit within the operation group.
+// Returns false if role is unknown.
+func basicACLRoleBit(role Role) (uint8, bool) {
+	switch role {
+	default:
+		return 0, false
+	case RoleOthers:
+		return 1, true
+	case RoleSystem:
+		return 2, true
+	case RoleUser:
+		return 3, true
+	}
+}
+
+func (x BasicACL) isBitSet(n uint8) bool {
+	return x&(1<<n) != 0
+}
+
+func (x *BasicACL) setBit(n uint8, v bool) {
+	if v {
+		*x |= 1 << n
+	} else {
+		*x &^= 1 << n
+	}
+}
+
+// IsOpAllowed checks if the operation is allowed to the role. Returns false
+// for unknown operations and roles.
+func (x BasicACL) IsOpAllowed(op Operation, role Role) bool {
+	rb, ok := basicACLRoleBit(role)
+	if !ok {
+		return false
+	}
+
+	n, ok := basicACLOpBit(op, rb)
+
+	return ok && x.isBitSet(n)
+}
+
+// SetOpAllowed allows or forbids the operation to the role. Panics on unknown
+// operations and roles.
+func (x *BasicACL) SetOpAllowed(op Operation, role Role, allow bool) {
+	rb, ok := basicACLRoleBit(role)
+	if !ok {
+		panic(fmt.Sprintf("unsupported role %d", role))
+	}
+
+	n, ok := basicACLOpBit(op, rb)
+	if !ok {
+		panic(fmt.Sprintf("unsupported operation %d", op))
+	}
+
+	x.setBit(n, allow)
+}
+
+// IsBearerAllowed checks if bearer token rules are allowed for the operation.
+// Returns false for unknown operations.
+func (x BasicACL) IsBearerAllowed(op Operation) bool {
+	n, ok := basicACLOpBit(op, basicACLBitBearer)
+
+	return ok && x.isBitSet(n)
+}
+
+// SetBearerAllowed allows or forbids bearer token rules for the operation.
+// Panics on unknown operations.
+func (x *BasicACL) SetBearerAllowed(op Operation, allow bool) {
+	n, ok := basicACLOpBit(op, basicACLBitBearer)
+	if !ok {
+		panic(fmt.Sprintf("unsupported operation %d", op))
+	}
+
+	x.setBit(n, allow)
+}
+
+// Sticky checks if the sticky bit is set. Objects of containers with sticky
+// basic ACL can be removed by their owners only.
+func (x BasicACL) Sticky() bool {
+	return x.isBitSet(basicACLBitSticky)
+}
+
+// SetSticky sets or unsets the sticky bit.
+func (x *BasicACL) SetSticky(sticky bool) {
+	x.setBit(basicACLBitSticky, sticky)
+}
+
+// Final checks if the final bit is set. Final basic ACL can not be extended
+// with the eACL rules.
+func (x BasicACL) Final() bool {
+	return x.isBitSet(basicACLBitFinal)
+}
+
+// SetFinal sets or unsets the final bit.
+func (x *BasicACL) SetFinal(final bool) {
+	x.setBit(basicACLBitFinal, final)
+}
+
+// String returns the name of the well-known preset (e.g. "public-read" or
+// "eacl-private") or the hexadecimal representation with 0x prefix.
+func (x BasicACL) String() string {
+	for i := range basicACLPresets {
+		if basicACLPresets[i].val == x {
+			return basicACLPresets[i].name
+		}
+	}
+
+	return fmt.Sprintf("0x%08x", uint32(x))
+}
+
+// FromString parses BasicACL from a string representation.
+// It is a reverse action to String(). Hexadecimal representation may be
+// provided without 0x prefix and in any case.
+//
+// Returns true if s was parsed successfully.
+func (x *BasicACL) FromString(s string) bool {
+	for i := range basicACLPresets {
+		if basicACLPresets[i].name == s {
+			*x = basicACLPresets[i].val
+			return true
+		}
+	}
+
+	if len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+
+	if s == "" {
+		return false
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return false
+	}
+
+	*x = BasicACL(v)
+
+	return true
+}
@@ -0,0 +1,115 @@
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/stretchr/testify/require"
+)
+
+var objectOps = []acl.Operation{
+	acl.OperationGet,
+	acl.OperationHead,
+	acl.OperationPut,
+	acl.OperationDelete,
+	acl.OperationSearch,
+	acl.OperationRange,
+	acl.OperationRangeHash,
+}
+
+func TestBasicACL(t *testing.T) {
+	var x acl.BasicACL
+
+	for _, op := range objectOps {
+		for _, role := range []acl.Role{acl.RoleUser, acl.RoleSystem, acl.RoleOthers} {
+			require.False(t, x.IsOpAllowed(op, role))
+			x.SetOpAllowed(op, role, true)
+			require.True(t, x.IsOpAllowed(op, role))
+		}
+
+		require.False(t, x.IsBearerAllowed(op))
+		x.SetBearerAllowed(op, true)
+		require.True(t, x.IsBearerAllowed(op))
+	}
+
+	require.EqualValues(t, 0x0FFFFFFF, x)
+
+	require.False(t, x.Final())
+	x.SetFinal(true)
+	require.True(t, x.Final())
+
+	require.False(t, x.Sticky())
+	x.SetSticky(true)
+	require.True(t, x.Sticky())
+
+	require.EqualValues(t, 0x3FFFFFFF, x)
+
+	x.SetOpAllowed(acl.OperationPut, acl.RoleOthers, false)
+	x.SetBearerAllowed(acl.OperationPut, false)
+	x.SetFinal(false)
+	x.SetSticky(false)
+	require.EqualValues(t, 0x0FFFFCFF, x)
+
+	require.False(t, x.IsOpAllowed(acl.OperationUnknown, acl.RoleUser))
+	require.False(t, x.IsOpAllowed(acl.OperationGet, acl.RoleUnknown))
+	require.False(t, x.IsBearerAllowed(acl.OperationRangeHash+1))
+	require.Panics(t, func() { x.SetOpAllowed(acl.OperationUnknown, acl.RoleUser, true) })
+	require.Panics(t, func() { x.SetOpAllowed(acl.OperationGet, acl.RoleUnknown, true) })
+	require.Panics(t, func() { x.SetBearerAllowed(acl.OperationUnknown, true) })
+}
+
+func TestBasicACLPresets(t *testing.T) {
+	for _, tc := range []struct {
+		x     acl.BasicACL
+		name  string
+		final bool
+		read  bool
+		write bool
+		del   bool
+	}{
+		{x: acl.BasicACLPrivate, name: "private", final: true},
+		{x: acl.BasicACLPrivateExtended, name: "eacl-private"},
+		{x: acl.BasicACLPublicRO, name: "public-read", final: true, read: true},
+		{x: acl.BasicACLPublicROExtended, name: "eacl-public-read", read: true},
+		{x: acl.BasicACLPublicRW, name: "public-read-write", final: true, read: true, write: true, del: true},
+		{x: acl.BasicACLPublicRWExtended, name: "eacl-public-read-write", read: true, write: true, del: true},
+		{x: acl.BasicACLPublicAppend, name: "public-append", final: true, read: true, write: true},
+		{x: acl.BasicACLPublicAppendExtended, name: "eacl-public-append", read: true, write: true},
+	} {
+		require.Equal(t, tc.name, tc.x.String())
+		require.Equal(t, tc.final, tc.x.Final(), tc.name)
+		require.False(t, tc.x.Sticky(), tc.name)
+
+		for _, op := range objectOps {
+			require.True(t, tc.x.IsOpAllowed(op, acl.RoleUser), tc.name)
+		}
+
+		require.Equal(t, tc.read, tc.x.IsOpAllowed(acl.OperationGet, acl.RoleOthers), tc.name)
+		require.Equal(t, tc.read, tc.x.IsOpAllowed(acl.OperationSearch, acl.RoleOthers), tc.name)
+		require.Equal(t, tc.write, tc.x.IsOpAllowed(acl.OperationPut, acl.RoleOthers), tc.name)
+		require.Equal(t, tc.del, tc.x.IsOpAllowed(acl.OperationDelete, acl.RoleOthers), tc.name)
+
+		var x acl.BasicACL
+		require.True(t, x.FromString(tc.name))
+		require.Equal(t, tc.x, x)
+	}
+}
+
+func TestBasicACL_String(t *testing.T) {
+	x := acl.BasicACL(0x1A2B3C)
+	require.Equal(t, "0x001a2b3c", x.String())
+
+	for _, s := range []string{"0x001a2b3c", "0X1A2B3C", "1a2b3c"} {
+		var y acl.BasicACL
+		require.True(t, y.FromString(s), s)
+		require.Equal(t, x, y, s)
+	}
+
+	var y acl.BasicACL
+	require.True(t, y.FromString(acl.BasicACLPublicRO.String()))
+	require.Equal(t, acl.BasicACLPublicRO, y)
+
+	for _, s := range []string{"", "0x", "public", "0x100000000", "-1", "0xZZ", "0x0x1"} {
+		require.False(t, y.FromString(s), s)
+	}
+}
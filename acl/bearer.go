@@ -0,0 +1,227 @@
+package acl
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/stable"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+// BearerTokenParams groups parameters of the bearer token issued by
+// IssueBearerToken.
+type BearerTokenParams struct {
+	// EACL is an eACL table of the container provided to the token owner.
+	// Required, must have container ID set.
+	EACL *Table
+
+	// Owner is the only user allowed to use the token. Optional, any user
+	// may use the token if nil.
+	Owner *refs.OwnerID
+
+	// IssuedAt is the epoch when the token was issued.
+	IssuedAt uint64
+
+	// NotBefore is the first epoch of the token validity.
+	NotBefore uint64
+
+	// Expiration is the last epoch of the token validity.
+	Expiration uint64
+}
+
+// IssueBearerToken creates a bearer token with the given parameters and signs
+// it using SignBearerToken.
+func IssueBearerToken(key *ecdsa.PrivateKey, prm BearerTokenParams) (*BearerToken, error) {
+	if prm.EACL == nil {
+		return nil, errors.New("missing eACL table")
+	}
+
+	if len(prm.EACL.GetContainerID().GetValue()) == 0 {
+		return nil, errors.New("missing container ID in eACL table")
+	}
+
+	var lt TokenLifetime
+	lt.SetIat(prm.IssuedAt)
+	lt.SetNbf(prm.NotBefore)
+	lt.SetExp(prm.Expiration)
+
+	var body BearerTokenBody
+	body.SetEACL(prm.EACL)
+	body.SetOwnerID(prm.Owner)
+	body.SetLifetime(&lt)
+
+	var res BearerToken
+	res.SetBody(&body)
+
+	err := SignBearerToken(key, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// SignBearerToken sets the token issuer to the owner ID derived from the key
+// and signs the token body.
+func SignBearerToken(key *ecdsa.PrivateKey, t *BearerToken) error {
+	if key == nil {
+		return errors.New("empty private key")
+	}
+
+	body := t.GetBody()
+	if body == nil {
+		body = new(BearerTokenBody)
+		t.SetBody(body)
+	}
+
+	var issuer refs.OwnerID
+	issuer.SetValue(ownerid.FromPublicKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y)))
+
+	body.SetIssuer(&issuer)
+
+	err := signature.SignDataWithHandler(key, stable.Data{M: body}, t.SetSignature)
+	if err != nil {
+		return fmt.Errorf("sign bearer token body: %w", err)
+	}
+
+	return nil
+}
+
+// BearerTokenViolationKind enumerates kinds of the bearer token problems found
+// by ValidateBearerToken.
+type BearerTokenViolationKind uint8
+
+const (
+	_ BearerTokenViolationKind = iota
+
+	// BearerTokenMissingBody is reported for tokens without body. No other
+	// checks are performed in this case.
+	BearerTokenMissingBody
+
+	// BearerTokenInvalidSignature is reported for tokens with missing or
+	// incorrect body signature.
+	BearerTokenInvalidSignature
+
+	// BearerTokenExpired is reported for tokens which expiration epoch is
+	// before the current one.
+	BearerTokenExpired
+
+	// BearerTokenNotYetValid is reported for tokens which validity period
+	// starts after the current epoch.
+	BearerTokenNotYetValid
+
+	// BearerTokenIssuedInFuture is reported for tokens issued after the
+	// current epoch.
+	BearerTokenIssuedInFuture
+
+	// BearerTokenIssuerMismatch is reported for tokens which issuer does not
+	// correspond to the signature key.
+	BearerTokenIssuerMismatch
+
+	// BearerTokenNotContainerOwner is reported for tokens issued not by the
+	// container owner.
+	BearerTokenNotContainerOwner
+
+	// BearerTokenWrongUser is reported for tokens issued to a user other than
+	// the request sender.
+	BearerTokenWrongUser
+)
+
+// BearerTokenViolation describes the problem of the bearer token found by
+// ValidateBearerToken.
+type BearerTokenViolation struct {
+	// Kind of the problem.
+	Kind BearerTokenViolationKind
+
+	// Reason explains the problem.
+	Reason string
+}
+
+func (x BearerTokenViolation) Error() string {
+	return x.Reason
+}
+
+// BearerTokenContext groups information about the request with the bearer
+// token checked by ValidateBearerToken.
+type BearerTokenContext struct {
+	// CurrentEpoch is the epoch of the request processing.
+	CurrentEpoch uint64
+
+	// ContainerOwner is the owner of the container the token is presented
+	// for. Optional, issuer is not checked if nil.
+	ContainerOwner *refs.OwnerID
+
+	// Sender is the request sender. Optional, token owner is not checked if
+	// nil.
+	Sender *refs.OwnerID
+}
+
+// ValidateBearerToken checks the bearer token in the given context and returns
+// all found problems. The token is valid if:
+//   - it has a body signed by the issuer, i.e. owner ID derived from the
+//     signature key is the issuer;
+//   - current epoch is within its lifetime: not before the issue and
+//     the validity start, not after the expiration;
+//   - the issuer is the container owner;
+//   - the token is issued to the sender or to any user (owner is not set).
+//
+// The result is empty if the token is valid.
+func ValidateBearerToken(t *BearerToken, ctx BearerTokenContext) []BearerTokenViolation {
+	var res []BearerTokenViolation
+
+	violation := func(kind BearerTokenViolationKind, format string, args ...any) {
+		res = append(res, BearerTokenViolation{
+			Kind:   kind,
+			Reason: fmt.Sprintf(format, args...),
+		})
+	}
+
+	body := t.GetBody()
+	if body == nil {
+		violation(BearerTokenMissingBody, "missing token body")
+		return res
+	}
+
+	sig := t.GetSignature()
+
+	err := signature.VerifyDataWithSource(stable.Data{M: body}, func() *refs.Signature { return sig })
+	if err != nil {
+		violation(BearerTokenInvalidSignature, "invalid body signature: %v", err)
+	}
+
+	lt := body.GetLifetime()
+	epoch := ctx.CurrentEpoch
+
+	if exp := lt.GetExp(); epoch > exp {
+		violation(BearerTokenExpired, "token expired at epoch %d, current is %d", exp, epoch)
+	}
+
+	if nbf := lt.GetNbf(); epoch < nbf {
+		violation(BearerTokenNotYetValid, "token is valid since epoch %d, current is %d", nbf, epoch)
+	}
+
+	if iat := lt.GetIat(); epoch < iat {
+		violation(BearerTokenIssuedInFuture, "token is issued at future epoch %d, current is %d", iat, epoch)
+	}
+
+	issuer := body.GetIssuer().GetValue()
+
+	if key := sig.GetKey(); len(key) != 0 && !bytes.Equal(issuer, ownerid.FromPublicKey(key)) {
+		violation(BearerTokenIssuerMismatch, "issuer does not correspond to the signature key")
+	}
+
+	if ctx.ContainerOwner != nil && !bytes.Equal(issuer, ctx.ContainerOwner.GetValue()) {
+		violation(BearerTokenNotContainerOwner, "issuer is not the container owner")
+	}
+
+	if owner := body.GetOwnerID(); owner != nil && ctx.Sender != nil && !bytes.Equal(owner.GetValue(), ctx.Sender.GetValue()) {
+		violation(BearerTokenWrongUser, "token is issued to another user")
+	}
+
+	return res
+}
@@ -0,0 +1,114 @@
+package acl_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func violationKinds(vs []acl.BearerTokenViolation) []acl.BearerTokenViolationKind {
+	var res []acl.BearerTokenViolationKind
+	for i := range vs {
+		res = append(res, vs[i].Kind)
+	}
+
+	return res
+}
+
+func TestBearerToken(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var cnr refs.ContainerID
+	cnr.SetValue(make([]byte, 32))
+
+	var table acl.Table
+	table.SetContainerID(&cnr)
+	table.SetRecords([]acl.Record{record(acl.ActionAllow, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)})})
+
+	var user, other refs.OwnerID
+	user.SetValue([]byte{1})
+	other.SetValue([]byte{2})
+
+	_, err = acl.IssueBearerToken(key, acl.BearerTokenParams{})
+	require.Error(t, err)
+
+	_, err = acl.IssueBearerToken(key, acl.BearerTokenParams{EACL: new(acl.Table)})
+	require.Error(t, err)
+
+	tok, err := acl.IssueBearerToken(key, acl.BearerTokenParams{
+		EACL:       &table,
+		Owner:      &user,
+		IssuedAt:   10,
+		NotBefore:  11,
+		Expiration: 20,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &table, tok.GetBody().GetEACL())
+	require.Len(t, tok.GetBody().GetIssuer().GetValue(), 25)
+
+	ctx := acl.BearerTokenContext{
+		CurrentEpoch:   15,
+		ContainerOwner: tok.GetBody().GetIssuer(),
+		Sender:         &user,
+	}
+
+	require.Empty(t, acl.ValidateBearerToken(tok, ctx))
+
+	t.Run("lifetime", func(t *testing.T) {
+		for epoch, kinds := range map[uint64][]acl.BearerTokenViolationKind{
+			9:  {acl.BearerTokenNotYetValid, acl.BearerTokenIssuedInFuture},
+			10: {acl.BearerTokenNotYetValid},
+			11: nil,
+			20: nil,
+			21: {acl.BearerTokenExpired},
+		} {
+			ctx := ctx
+			ctx.CurrentEpoch = epoch
+
+			require.Equal(t, kinds, violationKinds(acl.ValidateBearerToken(tok, ctx)), epoch)
+		}
+	})
+
+	t.Run("owners", func(t *testing.T) {
+		ctx := ctx
+		ctx.ContainerOwner = &other
+		ctx.Sender = &other
+
+		require.Equal(t, []acl.BearerTokenViolationKind{acl.BearerTokenNotContainerOwner, acl.BearerTokenWrongUser},
+			violationKinds(acl.ValidateBearerToken(tok, ctx)))
+
+		ctx.ContainerOwner = nil
+		ctx.Sender = nil
+
+		require.Empty(t, acl.ValidateBearerToken(tok, ctx))
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		tok.GetBody().GetLifetime().SetExp(30)
+
+		require.Equal(t, []acl.BearerTokenViolationKind{acl.BearerTokenInvalidSignature},
+			violationKinds(acl.ValidateBearerToken(tok, ctx)))
+
+		tok.GetBody().SetIssuer(&other)
+
+		require.Equal(t, []acl.BearerTokenViolationKind{acl.BearerTokenInvalidSignature, acl.BearerTokenIssuerMismatch},
+			violationKinds(acl.ValidateBearerToken(tok, ctx)[:2]))
+
+		require.NoError(t, acl.SignBearerToken(key, tok))
+		require.Empty(t, acl.ValidateBearerToken(tok, ctx))
+
+		tok.SetSignature(nil)
+
+		require.Equal(t, []acl.BearerTokenViolationKind{acl.BearerTokenInvalidSignature},
+			violationKinds(acl.ValidateBearerToken(tok, ctx)))
+	})
+
+	require.Equal(t, []acl.BearerTokenViolationKind{acl.BearerTokenMissingBody},
+		violationKinds(acl.ValidateBearerToken(new(acl.BearerToken), ctx)))
+}
@@ -0,0 +1,179 @@
+package acl
+
+import (
+	"bytes"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/decimal"
+)
+
+// Header is a key-value header processed by the eACL filters.
+type Header interface {
+	GetKey() string
+	GetValue() string
+}
+
+// HeaderSource returns headers of the particular type for the eACL
+// evaluation. Returns false if the headers can not be composed.
+type HeaderSource func() ([]Header, bool)
+
+// RequestContext groups information about the request evaluated against eACL
+// table.
+type RequestContext struct {
+	// Operation requested.
+	Operation Operation
+
+	// Role of the requester.
+	Role Role
+
+	// PublicKey of the requester in compressed form.
+	PublicKey []byte
+
+	// RequestHeaders provides headers of HeaderTypeRequest, i.e. X-headers.
+	RequestHeaders HeaderSource
+
+	// ObjectHeaders provides headers of HeaderTypeObject, i.e. object header
+	// fields under the keys with ObjectFilterPrefix and object attributes.
+	ObjectHeaders HeaderSource
+
+	// ServiceHeaders provides headers of HeaderTypeService.
+	ServiceHeaders HeaderSource
+}
+
+func (x *RequestContext) headers(typ HeaderType) ([]Header, bool) {
+	var src HeaderSource
+
+	switch typ {
+	default:
+		return nil, false
+	case HeaderTypeRequest:
+		src = x.RequestHeaders
+	case HeaderTypeObject:
+		src = x.ObjectHeaders
+	case HeaderTypeService:
+		src = x.ServiceHeaders
+	}
+
+	if src == nil {
+		return nil, false
+	}
+
+	return src()
+}
+
+// Evaluate evaluates the request against the eACL table and returns action
+// of the first matching record and its index in the table. Returns
+// ActionUnknown and -1 if none of the records matches, in which case the
+// access is decided by the basic ACL.
+//
+// Record matches the request if:
+//   - its operation is the requested one;
+//   - any of its targets matches: target with public keys matches the
+//     requester key only, target without keys matches the requester role;
+//   - all of its filters match.
+//
+// Filter matches if there is a header of the filter type with the filter key
+// whose value matches the filter value according to the matching type.
+// MatchTypeNotPresent filter matches if there is no header with the filter
+// key. Numeric matching types compare arbitrary-precision base-10 integers
+// and never match non-integer values. Filters of unknown header or matching
+// types never match, as well as filters of types which source is missing or
+// can not compose the headers.
+func Evaluate(t *Table, ctx *RequestContext) (Action, int) {
+	records := t.GetRecords()
+
+	for i := range records {
+		if records[i].GetOperation() != ctx.Operation {
+			continue
+		}
+
+		if !matchTargets(records[i].GetTargets(), ctx) {
+			continue
+		}
+
+		if !matchFilters(records[i].GetFilters(), ctx) {
+			continue
+		}
+
+		return records[i].GetAction(), i
+	}
+
+	return ActionUnknown, -1
+}
+
+func matchTargets(ts []Target, ctx *RequestContext) bool {
+	for i := range ts {
+		if keys := ts[i].GetKeys(); len(keys) != 0 {
+			for j := range keys {
+				if bytes.Equal(keys[j], ctx.PublicKey) {
+					return true
+				}
+			}
+
+			continue
+		}
+
+		if ts[i].GetRole() == ctx.Role {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchFilters(fs []HeaderFilter, ctx *RequestContext) bool {
+	for i := range fs {
+		hs, ok := ctx.headers(fs[i].GetHeaderType())
+		if !ok || !matchFilter(&fs[i], hs) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchFilter(f *HeaderFilter, hs []Header) bool {
+	key, mt := f.GetKey(), f.GetMatchType()
+
+	for i := range hs {
+		if hs[i] == nil || hs[i].GetKey() != key {
+			continue
+		}
+
+		if mt == MatchTypeNotPresent {
+			return false
+		}
+
+		if matchValue(mt, hs[i].GetValue(), f.GetValue()) {
+			return true
+		}
+	}
+
+	return mt == MatchTypeNotPresent
+}
+
+func matchValue(mt MatchType, hdrVal, filterVal string) bool {
+	switch mt {
+	default:
+		return false
+	case MatchTypeStringEqual:
+		return hdrVal == filterVal
+	case MatchTypeStringNotEqual:
+		return hdrVal != filterVal
+	case MatchTypeNumGT, MatchTypeNumGE, MatchTypeNumLT, MatchTypeNumLE:
+		c, ok := decimal.Compare(hdrVal, filterVal)
+		if !ok {
+			return false
+		}
+
+		switch mt {
+		case MatchTypeNumGT:
+			return c > 0
+		case MatchTypeNumGE:
+			return c >= 0
+		case MatchTypeNumLT:
+			return c < 0
+		default:
+			return c <= 0
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/stretchr/testify/require"
+)
+
+func headerFilter(typ acl.HeaderType, key string, mt acl.MatchType, val string) acl.HeaderFilter {
+	var f acl.HeaderFilter
+	f.SetHeaderType(typ)
+	f.SetKey(key)
+	f.SetMatchType(mt)
+	f.SetValue(val)
+
+	return f
+}
+
+func roleTarget(r acl.Role) acl.Target {
+	var t acl.Target
+	t.SetRole(r)
+
+	return t
+}
+
+func keysTarget(keys ...[]byte) acl.Target {
+	var t acl.Target
+	t.SetKeys(keys)
+
+	return t
+}
+
+func record(a acl.Action, op acl.Operation, ts []acl.Target, fs ...acl.HeaderFilter) acl.Record {
+	var r acl.Record
+	r.SetAction(a)
+	r.SetOperation(op)
+	r.SetTargets(ts)
+	r.SetFilters(fs)
+
+	return r
+}
+
+func staticHeaders(hs []acl.Header) acl.HeaderSource {
+	return func() ([]acl.Header, bool) { return hs, true }
+}
+
+func TestEvaluate(t *testing.T) {
+	var hdr object.Header
+	hdr.SetPayloadLength(2048)
+
+	attrs := make([]object.Attribute, 1)
+	attrs[0].SetKey("Classified")
+	attrs[0].SetValue("true")
+	hdr.SetAttributes(attrs)
+
+	xs := make([]session.XHeader, 1)
+	xs[0].SetKey("X-Tenant")
+	xs[0].SetValue("acme")
+
+	var origin session.RequestMetaHeader
+	origin.SetXHeaders(xs)
+
+	var meta session.RequestMetaHeader
+	meta.SetOrigin(&origin)
+
+	key := []byte{2, 1, 3}
+
+	ctx := acl.RequestContext{
+		Operation:      acl.OperationGet,
+		Role:           acl.RoleOthers,
+		PublicKey:      key,
+		RequestHeaders: staticHeaders(session.EACLRequestHeaders(&meta)),
+		ObjectHeaders:  staticHeaders(object.EACLHeaders(nil, &hdr)),
+	}
+
+	for _, tc := range []struct {
+		name   string
+		record acl.Record
+		match  bool
+	}{
+		{name: "other operation", record: record(acl.ActionDeny, acl.OperationPut, []acl.Target{roleTarget(acl.RoleOthers)})},
+		{name: "other role", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleUser)})},
+		{name: "role", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleUser), roleTarget(acl.RoleOthers)}), match: true},
+		{name: "key", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{keysTarget([]byte{1}, key)}), match: true},
+		{name: "other key", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{keysTarget([]byte{1})})},
+		{name: "attribute", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeStringEqual, "true")), match: true},
+		{name: "attribute mismatch", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeStringNotEqual, "true"))},
+		{name: "header field", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, acl.FilterObjectPayloadLength, acl.MatchTypeNumGT, "1024")), match: true},
+		{name: "numeric mismatch", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, acl.FilterObjectPayloadLength, acl.MatchTypeNumLE, "1024"))},
+		{name: "non-numeric", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeNumGE, "0"))},
+		{name: "not present", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, acl.FilterObjectOwnerID, acl.MatchTypeNotPresent, "")), match: true},
+		{name: "present", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeNotPresent, ""))},
+		{name: "X-header", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeRequest, "X-Tenant", acl.MatchTypeStringEqual, "acme"),
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeStringEqual, "true")), match: true},
+		{name: "missing source", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeService, "any", acl.MatchTypeNotPresent, ""))},
+		{name: "unknown match", record: record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeUnknown, "true"))},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var table acl.Table
+			table.SetRecords([]acl.Record{tc.record})
+
+			a, i := acl.Evaluate(&table, &ctx)
+			if tc.match {
+				require.Equal(t, acl.ActionDeny, a)
+				require.Zero(t, i)
+			} else {
+				require.Equal(t, acl.ActionUnknown, a)
+				require.Equal(t, -1, i)
+			}
+		})
+	}
+
+	t.Run("first match", func(t *testing.T) {
+		var table acl.Table
+		table.SetRecords([]acl.Record{
+			record(acl.ActionDeny, acl.OperationPut, []acl.Target{roleTarget(acl.RoleOthers)}),
+			record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget(key)}),
+			record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)}),
+		})
+
+		a, i := acl.Evaluate(&table, &ctx)
+		require.Equal(t, acl.ActionAllow, a)
+		require.Equal(t, 1, i)
+	})
+}
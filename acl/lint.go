@@ -0,0 +1,321 @@
+package acl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TableIssueKind enumerates kinds of the problems found by Lint.
+type TableIssueKind uint8
+
+const (
+	_ TableIssueKind = iota
+
+	// TableIssueShadowedRecord is reported for records that never take effect
+	// because an earlier record matches every request they match.
+	TableIssueShadowedRecord
+
+	// TableIssueConflictingRecords is reported for records having the same
+	// operation, targets and filters as an earlier record but the opposite
+	// action.
+	TableIssueConflictingRecords
+
+	// TableIssueNonNumericFilter is reported for filters with numeric matching
+	// type set for well-known object header keys with non-numeric values.
+	TableIssueNonNumericFilter
+
+	// TableIssueUnknownObjectKey is reported for object filters with keys
+	// having ObjectFilterPrefix but not corresponding to any object header
+	// field.
+	TableIssueUnknownObjectKey
+
+	// TableIssueEmptyTargets is reported for records without targets and for
+	// targets with neither role nor public keys, they never match anything.
+	TableIssueEmptyTargets
+
+	// TableIssueDuplicateKeys is reported for targets listing the same public
+	// key more than once.
+	TableIssueDuplicateKeys
+)
+
+// TableIssue describes the problem of the eACL table found by Lint.
+type TableIssue struct {
+	// Kind of the problem.
+	Kind TableIssueKind
+
+	// Record is an index of the problematic record in the table.
+	Record int
+
+	// Related is an index of the earlier record causing the problem for
+	// TableIssueShadowedRecord and TableIssueConflictingRecords, -1 otherwise.
+	Related int
+
+	// Message explains the problem.
+	Message string
+}
+
+func (x TableIssue) String() string {
+	return fmt.Sprintf("record #%d: %s", x.Record, x.Message)
+}
+
+// objectFilterKeys lists well-known object header keys and whether their
+// values are numeric.
+var objectFilterKeys = map[string]bool{
+	FilterObjectVersion:         false,
+	FilterObjectID:              false,
+	FilterObjectContainerID:     false,
+	FilterObjectOwnerID:         false,
+	FilterObjectCreationEpoch:   true,
+	FilterObjectPayloadLength:   true,
+	FilterObjectPayloadHash:     false,
+	FilterObjectType:            false,
+	FilterObjectHomomorphicHash: false,
+}
+
+// Lint statically analyzes the eACL table and returns found problems ordered
+// by record index. The analysis follows Evaluate semantics:
+//   - record is shadowed by the earlier record of the same operation if all
+//     filters of the earlier record are also present in the later one, and
+//     each target of the later record is covered by the earlier record: key
+//     targets by the same keys, role targets by the same roles, or any target
+//     by the earlier record covering all of RoleUser, RoleSystem and
+//     RoleOthers;
+//   - shadowed record with the same targets and filters but the opposite
+//     action is reported as TableIssueConflictingRecords rather than
+//     TableIssueShadowedRecord.
+//
+// Each record is reported as shadowed at most once, with the first record
+// causing it. The result is empty if no problems are found.
+func Lint(t *Table) []TableIssue {
+	var res []TableIssue
+
+	records := t.GetRecords()
+
+	for i := range records {
+		res = append(res, lintRecord(&records[i], i)...)
+
+		for j := 0; j < i; j++ {
+			if !shadows(&records[j], &records[i]) {
+				continue
+			}
+
+			a1, a2 := records[j].GetAction(), records[i].GetAction()
+
+			if a1 != a2 && sameTargetsAndFilters(&records[j], &records[i]) {
+				res = append(res, TableIssue{
+					Kind:    TableIssueConflictingRecords,
+					Record:  i,
+					Related: j,
+					Message: fmt.Sprintf("conflicts with record #%d: same targets and filters, but %s instead of %s", j, a2, a1),
+				})
+			} else {
+				res = append(res, TableIssue{
+					Kind:    TableIssueShadowedRecord,
+					Record:  i,
+					Related: j,
+					Message: fmt.Sprintf("never matches: shadowed by broader record #%d", j),
+				})
+			}
+
+			break
+		}
+	}
+
+	return res
+}
+
+func lintRecord(r *Record, i int) []TableIssue {
+	var res []TableIssue
+
+	issue := func(kind TableIssueKind, format string, args ...any) {
+		res = append(res, TableIssue{
+			Kind:    kind,
+			Record:  i,
+			Related: -1,
+			Message: fmt.Sprintf(format, args...),
+		})
+	}
+
+	filters := r.GetFilters()
+
+	for j := range filters {
+		if filters[j].GetHeaderType() != HeaderTypeObject {
+			continue
+		}
+
+		key := filters[j].GetKey()
+		if !strings.HasPrefix(key, ObjectFilterPrefix) {
+			continue
+		}
+
+		numeric, ok := objectFilterKeys[key]
+		if !ok {
+			issue(TableIssueUnknownObjectKey, "filter #%d: unknown object header key %q", j, key)
+			continue
+		}
+
+		switch mt := filters[j].GetMatchType(); mt {
+		default:
+		case MatchTypeNumGT, MatchTypeNumGE, MatchTypeNumLT, MatchTypeNumLE:
+			if !numeric {
+				issue(TableIssueNonNumericFilter, "filter #%d: numeric matching %s of non-numeric key %q", j, mt, key)
+			}
+		}
+	}
+
+	targets := r.GetTargets()
+	if len(targets) == 0 {
+		issue(TableIssueEmptyTargets, "never matches: no targets")
+	}
+
+	for j := range targets {
+		keys := targets[j].GetKeys()
+
+		if len(keys) == 0 && targets[j].GetRole() == RoleUnknown {
+			issue(TableIssueEmptyTargets, "target #%d: neither role nor keys are set", j)
+			continue
+		}
+
+		seen := make(map[string]struct{}, len(keys))
+
+		for k := range keys {
+			if _, ok := seen[string(keys[k])]; ok {
+				issue(TableIssueDuplicateKeys, "target #%d: duplicated key #%d", j, k)
+				continue
+			}
+
+			seen[string(keys[k])] = struct{}{}
+		}
+	}
+
+	return res
+}
+
+// shadows checks whether r1 matches every request matched by r2 according
+// to Evaluate.
+func shadows(r1, r2 *Record) bool {
+	if r1.GetOperation() != r2.GetOperation() {
+		return false
+	}
+
+	fs2 := r2.GetFilters()
+
+	for _, f := range r1.GetFilters() {
+		if !containsFilter(fs2, &f) {
+			return false
+		}
+	}
+
+	ts1, ts2 := r1.GetTargets(), r2.GetTargets()
+	if len(ts1) == 0 || len(ts2) == 0 {
+		// records without targets never match, they are reported separately
+		return false
+	}
+
+	var (
+		roles = make(map[Role]struct{}, len(ts1))
+		keys  = make(map[string]struct{})
+	)
+
+	for i := range ts1 {
+		if ks := ts1[i].GetKeys(); len(ks) != 0 {
+			for j := range ks {
+				keys[string(ks[j])] = struct{}{}
+			}
+		} else {
+			roles[ts1[i].GetRole()] = struct{}{}
+		}
+	}
+
+	_, user := roles[RoleUser]
+	_, system := roles[RoleSystem]
+	_, others := roles[RoleOthers]
+
+	if user && system && others {
+		return true
+	}
+
+	for _, t := range ts2 {
+		if ks := t.GetKeys(); len(ks) != 0 {
+			for j := range ks {
+				if _, ok := keys[string(ks[j])]; !ok {
+					return false
+				}
+			}
+		} else if _, ok := roles[t.GetRole()]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sameTargetsAndFilters checks whether records have the same sets of targets
+// and filters.
+func sameTargetsAndFilters(r1, r2 *Record) bool {
+	fs1, fs2 := r1.GetFilters(), r2.GetFilters()
+
+	for i := range fs1 {
+		if !containsFilter(fs2, &fs1[i]) {
+			return false
+		}
+	}
+
+	for i := range fs2 {
+		if !containsFilter(fs1, &fs2[i]) {
+			return false
+		}
+	}
+
+	return targetSet(r1.GetTargets()) == targetSet(r2.GetTargets())
+}
+
+func containsFilter(fs []HeaderFilter, f *HeaderFilter) bool {
+	for i := range fs {
+		if fs[i].GetHeaderType() == f.GetHeaderType() &&
+			fs[i].GetMatchType() == f.GetMatchType() &&
+			fs[i].GetKey() == f.GetKey() &&
+			fs[i].GetValue() == f.GetValue() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// targetSet returns canonical string representation of the set of requests
+// matched by the targets.
+func targetSet(ts []Target) string {
+	var (
+		roles [RoleOthers + 1]bool
+		keys  []string
+	)
+
+	for i := range ts {
+		if ks := ts[i].GetKeys(); len(ks) != 0 {
+			for j := range ks {
+				keys = append(keys, string(ks[j]))
+			}
+		} else if r := ts[i].GetRole(); r <= RoleOthers {
+			roles[r] = true
+		}
+	}
+
+	parts := make([]string, 0, len(keys)+1)
+	parts = append(parts, fmt.Sprint(roles))
+
+	seen := make(map[string]struct{}, len(keys))
+
+	for _, k := range keys {
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			parts = append(parts, fmt.Sprintf("%x", k))
+		}
+	}
+
+	// order of keys does not matter
+	sort.Strings(parts[1:])
+
+	return strings.Join(parts, ",")
+}
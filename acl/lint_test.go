@@ -0,0 +1,103 @@
+package acl_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLint(t *testing.T) {
+	others := []acl.Target{roleTarget(acl.RoleOthers)}
+	everyone := []acl.Target{roleTarget(acl.RoleUser), roleTarget(acl.RoleSystem), roleTarget(acl.RoleOthers)}
+	classified := headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeStringEqual, "true")
+
+	for _, tc := range []struct {
+		name    string
+		records []acl.Record
+		issues  []acl.TableIssue
+	}{
+		{
+			name: "clean",
+			records: []acl.Record{
+				record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget([]byte{1}, []byte{2})}, classified),
+				record(acl.ActionDeny, acl.OperationGet, others, classified),
+				record(acl.ActionDeny, acl.OperationGet, everyone,
+					headerFilter(acl.HeaderTypeObject, acl.FilterObjectPayloadLength, acl.MatchTypeNumGT, "1024")),
+			},
+		},
+		{
+			name: "shadowed by broader filters",
+			records: []acl.Record{
+				record(acl.ActionDeny, acl.OperationGet, others, classified),
+				record(acl.ActionDeny, acl.OperationPut, others),
+				record(acl.ActionAllow, acl.OperationGet, others, classified,
+					headerFilter(acl.HeaderTypeRequest, "X-Tenant", acl.MatchTypeStringEqual, "acme")),
+			},
+			issues: []acl.TableIssue{{Kind: acl.TableIssueShadowedRecord, Record: 2, Related: 0}},
+		},
+		{
+			name: "shadowed by all roles",
+			records: []acl.Record{
+				record(acl.ActionDeny, acl.OperationGet, everyone),
+				record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget([]byte{1})}),
+			},
+			issues: []acl.TableIssue{{Kind: acl.TableIssueShadowedRecord, Record: 1, Related: 0}},
+		},
+		{
+			name: "keys are not covered by role",
+			records: []acl.Record{
+				record(acl.ActionDeny, acl.OperationGet, others),
+				record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget([]byte{1})}),
+			},
+		},
+		{
+			name: "conflict",
+			records: []acl.Record{
+				record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget([]byte{1}, []byte{2})}, classified),
+				record(acl.ActionDeny, acl.OperationGet, []acl.Target{keysTarget([]byte{2}), keysTarget([]byte{1})}, classified),
+			},
+			issues: []acl.TableIssue{{Kind: acl.TableIssueConflictingRecords, Record: 1, Related: 0}},
+		},
+		{
+			name: "filters",
+			records: []acl.Record{
+				record(acl.ActionDeny, acl.OperationGet, others,
+					headerFilter(acl.HeaderTypeObject, acl.FilterObjectOwnerID, acl.MatchTypeNumGE, "1"),
+					headerFilter(acl.HeaderTypeObject, acl.ObjectFilterPrefix+"size", acl.MatchTypeStringEqual, "1"),
+					headerFilter(acl.HeaderTypeRequest, acl.ObjectFilterPrefix+"size", acl.MatchTypeNumLT, "1")),
+			},
+			issues: []acl.TableIssue{
+				{Kind: acl.TableIssueNonNumericFilter, Record: 0, Related: -1},
+				{Kind: acl.TableIssueUnknownObjectKey, Record: 0, Related: -1},
+			},
+		},
+		{
+			name: "targets",
+			records: []acl.Record{
+				record(acl.ActionDeny, acl.OperationGet, nil),
+				record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleUnknown), keysTarget([]byte{1}, []byte{2}, []byte{1})}),
+			},
+			issues: []acl.TableIssue{
+				{Kind: acl.TableIssueEmptyTargets, Record: 0, Related: -1},
+				{Kind: acl.TableIssueEmptyTargets, Record: 1, Related: -1},
+				{Kind: acl.TableIssueDuplicateKeys, Record: 1, Related: -1},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var table acl.Table
+			table.SetRecords(tc.records)
+
+			issues := acl.Lint(&table)
+			require.Len(t, issues, len(tc.issues))
+
+			for i := range issues {
+				require.NotEmpty(t, issues[i].Message)
+				issues[i].Message = ""
+			}
+
+			require.Equal(t, tc.issues, issues)
+		})
+	}
+}
@@ -0,0 +1,589 @@
+package acl
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/lex"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+const (
+	rulesDirectiveVersion   = "version"
+	rulesDirectiveContainer = "container"
+
+	rulesRoleKeysOnly = "pubkey"
+)
+
+var rulesHeaderTypes = []struct {
+	prefix string
+	typ    HeaderType
+}{
+	{"req", HeaderTypeRequest},
+	{"obj", HeaderTypeObject},
+	{"svc", HeaderTypeService},
+}
+
+var rulesOperators = []struct {
+	op string
+	mt MatchType
+}{
+	{"!=", MatchTypeStringNotEqual},
+	{">=", MatchTypeNumGE},
+	{"<=", MatchTypeNumLE},
+	{"=", MatchTypeStringEqual},
+	{">", MatchTypeNumGT},
+	{"<", MatchTypeNumLT},
+}
+
+// RulesSyntaxError describes syntax error of the eACL rules text.
+type RulesSyntaxError struct {
+	// Line is a 1-based line number.
+	Line int
+
+	// Column is a 1-based position (in characters) of the error in the line.
+	Column int
+
+	// Message describes the error.
+	Message string
+}
+
+func (x *RulesSyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at line %d, column %d: %s", x.Line, x.Column, x.Message)
+}
+
+type rulesLine struct {
+	num  int
+	text string
+	pos  int
+}
+
+func (x *rulesLine) errorf(pos int, format string, args ...any) error {
+	return &RulesSyntaxError{
+		Line:    x.num,
+		Column:  utf8.RuneCountInString(x.text[:pos]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (x *rulesLine) skipSpaces() {
+	for x.pos < len(x.text) && (x.text[x.pos] == ' ' || x.text[x.pos] == '\t') {
+		x.pos++
+	}
+}
+
+// eol checks whether there are no more words in the line. Comments are
+// treated as line ends.
+func (x *rulesLine) eol() bool {
+	x.skipSpaces()
+	return x.pos == len(x.text) || x.text[x.pos] == '#'
+}
+
+// readWord reads bare word or quoted string. Bare words end at spaces,
+// comment sign and any of the stop characters.
+func (x *rulesLine) readWord(stop string) (string, error) {
+	start := x.pos
+
+	if x.pos < len(x.text) && x.text[x.pos] == '"' {
+		s, n, err := lex.QuotedString(x.text[x.pos:])
+		if err != nil {
+			return "", x.errorf(start, "%v", err)
+		}
+
+		x.pos += n
+
+		return s, nil
+	}
+
+	for x.pos < len(x.text) && !strings.ContainsRune(" \t#\""+stop, rune(x.text[x.pos])) {
+		x.pos++
+	}
+
+	return x.text[start:x.pos], nil
+}
+
+// nextWord reads next bare word up to the space.
+func (x *rulesLine) nextWord() (string, int) {
+	x.skipSpaces()
+
+	start := x.pos
+	w, _ := x.readWord("")
+
+	return w, start
+}
+
+// ParseRules parses the text of eACL rules into the eACL table. Each non-blank
+// line is a record in the form
+//
+//	<action> <operation> [<filter>...] [<target>...]
+//
+// where action is allow or deny, operation is get, head, put, delete, search,
+// getrange or getrangehash. Filter is a header type prefix (req for
+// HeaderTypeRequest, obj for HeaderTypeObject, svc for HeaderTypeService)
+// followed by a colon and the condition:
+//
+//	key=value   MatchTypeStringEqual
+//	key!=value  MatchTypeStringNotEqual
+//	key>value   MatchTypeNumGT
+//	key>=value  MatchTypeNumGE
+//	key<value   MatchTypeNumLT
+//	key<=value  MatchTypeNumLE
+//	!key        MatchTypeNotPresent
+//
+// Target is either a role (user, system or others) or a comma-separated list of
+// hex-encoded public keys prefixed with the role or pubkey word (for targets
+// without role) and a colon.
+//
+// Keys and values are either bare words or double-quoted Go string literals.
+// Bare keys must not contain spaces, quotes, '#', '=', '!', '<' and '>'
+// characters, bare values - spaces, quotes and '#'.
+//
+// Everything after '#' is a comment. Lines starting with the version and
+// container words set version ("vX.Y") and Base58-encoded container ID of the
+// table respectively.
+//
+// Example:
+//
+//	container 5HqniP5vq5xXr3FdijTSekrQJHu1WnADt2uLg7KSViZM
+//	allow get req:X-Tenant=acme pubkey:031a6c6fbbdf02ca351745fa86b9ba5a9452d785ac4f7fc2b7548ca2a46c4fcf4a
+//	deny get obj:Classified=true obj:$Object:payloadLength>1024 others
+//
+// Returns *RulesSyntaxError if the text is malformed.
+func ParseRules(s string) (*Table, error) {
+	var (
+		res     Table
+		records []Record
+	)
+
+	for i, text := range strings.Split(s, "\n") {
+		ln := rulesLine{num: i + 1, text: strings.TrimSuffix(text, "\r")}
+
+		if ln.eol() {
+			continue
+		}
+
+		w, pos := ln.nextWord()
+
+		switch w {
+		case rulesDirectiveVersion:
+			if len(records) != 0 || res.GetVersion() != nil {
+				return nil, ln.errorf(pos, "unexpected %s directive", w)
+			}
+
+			w, pos = ln.nextWord()
+
+			v, ok := parseRulesVersion(w)
+			if !ok {
+				return nil, ln.errorf(pos, "invalid version %q", w)
+			}
+
+			res.SetVersion(v)
+		case rulesDirectiveContainer:
+			if len(records) != 0 || res.GetContainerID() != nil {
+				return nil, ln.errorf(pos, "unexpected %s directive", w)
+			}
+
+			w, pos = ln.nextWord()
+
+			b, err := base58.Decode(w)
+			if err != nil || len(b) == 0 {
+				return nil, ln.errorf(pos, "invalid container ID %q", w)
+			}
+
+			var cid refs.ContainerID
+			cid.SetValue(b)
+
+			res.SetContainerID(&cid)
+		default:
+			var r Record
+
+			err := parseRulesRecord(&ln, &r, w, pos)
+			if err != nil {
+				return nil, err
+			}
+
+			records = append(records, r)
+
+			continue
+		}
+
+		if !ln.eol() {
+			return nil, ln.errorf(ln.pos, "unexpected text after %s directive", w)
+		}
+	}
+
+	res.SetRecords(records)
+
+	return &res, nil
+}
+
+func parseRulesVersion(s string) (*refs.Version, bool) {
+	s, ok := strings.CutPrefix(s, "v")
+	if !ok {
+		return nil, false
+	}
+
+	mjr, mnr, ok := strings.Cut(s, ".")
+	if !ok {
+		return nil, false
+	}
+
+	n1, err := strconv.ParseUint(mjr, 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	n2, err := strconv.ParseUint(mnr, 10, 32)
+	if err != nil {
+		return nil, false
+	}
+
+	var v refs.Version
+	v.SetMajor(uint32(n1))
+	v.SetMinor(uint32(n2))
+
+	return &v, true
+}
+
+func parseRulesRecord(ln *rulesLine, r *Record, w string, pos int) error {
+	var action Action
+	if strings.ToLower(w) != w || !action.FromString(strings.ToUpper(w)) || action == ActionUnknown {
+		return ln.errorf(pos, "expected action (allow or deny), got %q", w)
+	}
+
+	w, pos = ln.nextWord()
+
+	var op Operation
+	if strings.ToLower(w) != w || !op.FromString(strings.ToUpper(w)) || op == OperationUnknown {
+		return ln.errorf(pos, "expected operation, got %q", w)
+	}
+
+	var (
+		filters []HeaderFilter
+		targets []Target
+	)
+
+	for !ln.eol() {
+		start := ln.pos
+
+		prefix, _ := ln.readWord(":")
+		if ln.pos == len(ln.text) || ln.text[ln.pos] != ':' {
+			if prefix == rulesRoleKeysOnly {
+				return ln.errorf(start, "expected public keys after %q", rulesRoleKeysOnly)
+			}
+
+			var t Target
+
+			if !parseRulesRole(prefix, &t) {
+				return ln.errorf(start, "expected filter or target, got %q", prefix)
+			}
+
+			targets = append(targets, t)
+
+			continue
+		}
+
+		ln.pos++ // colon
+
+		hdrType := HeaderTypeUnknown
+
+		for i := range rulesHeaderTypes {
+			if rulesHeaderTypes[i].prefix == prefix {
+				hdrType = rulesHeaderTypes[i].typ
+				break
+			}
+		}
+
+		if hdrType == HeaderTypeUnknown {
+			var t Target
+
+			if !parseRulesRole(prefix, &t) {
+				return ln.errorf(start, "unknown filter header type or target role %q", prefix)
+			}
+
+			keys, err := parseRulesKeys(ln)
+			if err != nil {
+				return err
+			}
+
+			t.SetKeys(keys)
+			targets = append(targets, t)
+
+			continue
+		}
+
+		var f HeaderFilter
+		f.SetHeaderType(hdrType)
+
+		err := parseRulesCondition(ln, &f)
+		if err != nil {
+			return err
+		}
+
+		filters = append(filters, f)
+	}
+
+	r.SetAction(action)
+	r.SetOperation(op)
+	r.SetFilters(filters)
+	r.SetTargets(targets)
+
+	return nil
+}
+
+func parseRulesRole(s string, t *Target) bool {
+	if s == rulesRoleKeysOnly {
+		t.SetRole(RoleUnknown)
+		return true
+	}
+
+	var role Role
+	if strings.ToLower(s) != s || !role.FromString(strings.ToUpper(s)) || role == RoleUnknown {
+		return false
+	}
+
+	t.SetRole(role)
+
+	return true
+}
+
+func parseRulesKeys(ln *rulesLine) ([][]byte, error) {
+	start := ln.pos
+	w, _ := ln.readWord("")
+
+	if w == "" {
+		return nil, ln.errorf(start, "expected public keys")
+	}
+
+	var (
+		keys [][]byte
+		off  int
+	)
+
+	for _, s := range strings.Split(w, ",") {
+		key, err := hex.DecodeString(s)
+		if err != nil || len(key) == 0 {
+			return nil, ln.errorf(start+off, "invalid hex public key %q", s)
+		}
+
+		keys = append(keys, key)
+		off += len(s) + 1
+	}
+
+	return keys, nil
+}
+
+func parseRulesCondition(ln *rulesLine, f *HeaderFilter) error {
+	start := ln.pos
+
+	if ln.pos < len(ln.text) && ln.text[ln.pos] == '!' {
+		ln.pos++
+
+		keyStart := ln.pos
+
+		key, err := ln.readWord("=!<>")
+		if err != nil {
+			return err
+		}
+
+		if ln.pos == keyStart {
+			return ln.errorf(ln.pos, "expected filter key")
+		}
+
+		f.SetKey(key)
+		f.SetMatchType(MatchTypeNotPresent)
+
+		return nil
+	}
+
+	key, err := ln.readWord("=!<>")
+	if err != nil {
+		return err
+	}
+
+	if ln.pos == start {
+		return ln.errorf(ln.pos, "expected filter key")
+	}
+
+	i := lex.Operator(ln.text[ln.pos:], len(rulesOperators), func(i int) string { return rulesOperators[i].op })
+	if i < 0 {
+		return ln.errorf(ln.pos, "expected filter operator")
+	}
+
+	mt := rulesOperators[i].mt
+	ln.pos += len(rulesOperators[i].op)
+
+	val, err := ln.readWord("")
+	if err != nil {
+		return err
+	}
+
+	f.SetKey(key)
+	f.SetMatchType(mt)
+	f.SetValue(val)
+
+	return nil
+}
+
+// FormatRules formats the eACL table into the text of eACL rules (see
+// ParseRules), one line per record. Filters and targets of each record go
+// in their original order, filters first. Keys and values are quoted only
+// if needed.
+//
+// Returns an error if the table contains values having no text representation:
+// records of unknown action or operation, filters of unknown header or
+// matching type, MatchTypeNotPresent filters with values, targets with
+// unknown role and no keys.
+func FormatRules(t *Table) (string, error) {
+	var sb strings.Builder
+
+	if v := t.GetVersion(); v != nil {
+		fmt.Fprintf(&sb, "%s v%d.%d\n", rulesDirectiveVersion, v.GetMajor(), v.GetMinor())
+	}
+
+	if cid := t.GetContainerID(); cid != nil {
+		sb.WriteString(rulesDirectiveContainer + " " + base58.Encode(cid.GetValue()) + "\n")
+	}
+
+	records := t.GetRecords()
+
+	for i := range records {
+		err := formatRulesRecord(&sb, &records[i])
+		if err != nil {
+			return "", fmt.Errorf("record #%d: %w", i, err)
+		}
+
+		sb.WriteByte('\n')
+	}
+
+	return sb.String(), nil
+}
+
+func formatRulesRecord(sb *strings.Builder, r *Record) error {
+	action, op := r.GetAction(), r.GetOperation()
+
+	switch action {
+	default:
+		return fmt.Errorf("unsupported action %d", action)
+	case ActionAllow, ActionDeny:
+	}
+
+	if op < OperationGet || op > OperationRangeHash {
+		return fmt.Errorf("unsupported operation %d", op)
+	}
+
+	sb.WriteString(strings.ToLower(action.String()) + " " + strings.ToLower(op.String()))
+
+	filters := r.GetFilters()
+
+	for i := range filters {
+		sb.WriteByte(' ')
+
+		err := formatRulesFilter(sb, &filters[i])
+		if err != nil {
+			return fmt.Errorf("filter #%d: %w", i, err)
+		}
+	}
+
+	targets := r.GetTargets()
+
+	for i := range targets {
+		role, keys := targets[i].GetRole(), targets[i].GetKeys()
+
+		var roleStr string
+
+		switch role {
+		default:
+			return fmt.Errorf("target #%d: unsupported role %d", i, role)
+		case RoleUnknown:
+			if len(keys) == 0 {
+				return fmt.Errorf("target #%d: neither role nor keys are set", i)
+			}
+
+			roleStr = rulesRoleKeysOnly
+		case RoleUser, RoleSystem, RoleOthers:
+			roleStr = strings.ToLower(role.String())
+		}
+
+		sb.WriteString(" " + roleStr)
+
+		for j := range keys {
+			if len(keys[j]) == 0 {
+				return fmt.Errorf("target #%d: empty key #%d", i, j)
+			}
+
+			if j == 0 {
+				sb.WriteByte(':')
+			} else {
+				sb.WriteByte(',')
+			}
+
+			sb.WriteString(hex.EncodeToString(keys[j]))
+		}
+	}
+
+	return nil
+}
+
+func formatRulesFilter(sb *strings.Builder, f *HeaderFilter) error {
+	var prefix string
+
+	for i := range rulesHeaderTypes {
+		if rulesHeaderTypes[i].typ == f.GetHeaderType() {
+			prefix = rulesHeaderTypes[i].prefix
+			break
+		}
+	}
+
+	if prefix == "" {
+		return fmt.Errorf("unsupported header type %d", f.GetHeaderType())
+	}
+
+	sb.WriteString(prefix + ":")
+
+	mt := f.GetMatchType()
+
+	if mt == MatchTypeNotPresent {
+		if f.GetValue() != "" {
+			return fmt.Errorf("value is not supported by %s matching", mt)
+		}
+
+		sb.WriteString("!" + quoteRulesWord(f.GetKey(), "=!<>"))
+
+		return nil
+	}
+
+	var op string
+
+	for i := range rulesOperators {
+		if rulesOperators[i].mt == mt {
+			op = rulesOperators[i].op
+			break
+		}
+	}
+
+	if op == "" {
+		return fmt.Errorf("unsupported matching type %d", mt)
+	}
+
+	sb.WriteString(quoteRulesWord(f.GetKey(), "=!<>") + op + quoteRulesWord(f.GetValue(), ""))
+
+	return nil
+}
+
+// quoteRulesWord quotes s if it can not be written as a bare word with
+// the given stop characters.
+func quoteRulesWord(s string, stop string) string {
+	if s == "" || strings.ContainsAny(s, " \t#\""+stop) || strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsPrint(r)
+	}) >= 0 {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
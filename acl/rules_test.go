@@ -0,0 +1,135 @@
+package acl_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRules(t *testing.T) {
+	table, err := acl.ParseRules(`
+# tenant rules
+version v2.13
+container 5HqniP5vq5xXr3FdijTSekrQJHu1WnADt2uLg7KSViZM
+
+allow put req:X-Tenant=acme pubkey:03ab,0102 # tenant keys
+deny get obj:Classified=true obj:$Object:payloadLength>=1024 others
+deny getrangehash obj:!"Not Present" svc:"a b"!="\t" user system:ff
+	allow head
+`)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 2, table.GetVersion().GetMajor())
+	require.EqualValues(t, 13, table.GetVersion().GetMinor())
+	require.Len(t, table.GetContainerID().GetValue(), 32)
+
+	require.Equal(t, []acl.Record{
+		record(acl.ActionAllow, acl.OperationPut, []acl.Target{keysTarget([]byte{0x03, 0xab}, []byte{1, 2})},
+			headerFilter(acl.HeaderTypeRequest, "X-Tenant", acl.MatchTypeStringEqual, "acme")),
+		record(acl.ActionDeny, acl.OperationGet, []acl.Target{roleTarget(acl.RoleOthers)},
+			headerFilter(acl.HeaderTypeObject, "Classified", acl.MatchTypeStringEqual, "true"),
+			headerFilter(acl.HeaderTypeObject, acl.FilterObjectPayloadLength, acl.MatchTypeNumGE, "1024")),
+		record(acl.ActionDeny, acl.OperationRangeHash, []acl.Target{roleTarget(acl.RoleUser), func() acl.Target {
+			t := roleTarget(acl.RoleSystem)
+			t.SetKeys([][]byte{{0xff}})
+			return t
+		}()},
+			headerFilter(acl.HeaderTypeObject, "Not Present", acl.MatchTypeNotPresent, ""),
+			headerFilter(acl.HeaderTypeService, "a b", acl.MatchTypeStringNotEqual, "\t")),
+		record(acl.ActionAllow, acl.OperationHead, nil),
+	}, table.GetRecords())
+
+	table, err = acl.ParseRules("# nothing\n\n")
+	require.NoError(t, err)
+	require.Empty(t, table.GetRecords())
+
+	for _, tc := range []struct {
+		text   string
+		line   int
+		column int
+	}{
+		{text: "permit get", line: 1, column: 1},
+		{text: "\nALLOW get", line: 2, column: 1},
+		{text: "allow", line: 1, column: 6},
+		{text: "allow read", line: 1, column: 7},
+		{text: "allow get everyone", line: 1, column: 11},
+		{text: "allow get hdr:a=b", line: 1, column: 11},
+		{text: "allow get obj:a", line: 1, column: 16},
+		{text: "allow get obj:a~b", line: 1, column: 18},
+		{text: "allow get obj:=b", line: 1, column: 15},
+		{text: "allow get obj:!", line: 1, column: 16},
+		{text: "allow get obj:a=\"b", line: 1, column: 17},
+		{text: "allow get obj:a=\"\\q\"", line: 1, column: 17},
+		{text: "allow get pubkey", line: 1, column: 11},
+		{text: "allow get pubkey others", line: 1, column: 11},
+		{text: "allow get pubkey:", line: 1, column: 18},
+		{text: "allow get pubkey:01,xyz", line: 1, column: 21},
+		{text: "allow get\nversion v2.13", line: 2, column: 1},
+		{text: "version 2.13", line: 1, column: 9},
+		{text: "version v2.13 v2.14", line: 1, column: 15},
+		{text: "container 0OIl", line: 1, column: 11},
+		{text: "Ключ get", line: 1, column: 1},
+		{text: "allow get obj:\"Ключ\"=1 все", line: 1, column: 24},
+	} {
+		_, err := acl.ParseRules(tc.text)
+
+		var e *acl.RulesSyntaxError
+		require.True(t, errors.As(err, &e), tc.text)
+		require.Equal(t, tc.line, e.Line, tc.text)
+		require.Equal(t, tc.column, e.Column, tc.text)
+	}
+}
+
+func TestFormatRules(t *testing.T) {
+	var ver refs.Version
+	ver.SetMajor(2)
+	ver.SetMinor(13)
+
+	var cid refs.ContainerID
+	cid.SetValue([]byte{1, 2, 3})
+
+	var table acl.Table
+	table.SetVersion(&ver)
+	table.SetContainerID(&cid)
+	table.SetRecords([]acl.Record{
+		record(acl.ActionAllow, acl.OperationPut, []acl.Target{keysTarget([]byte{0x03, 0xab}, []byte{1, 2})},
+			headerFilter(acl.HeaderTypeRequest, "X-Tenant", acl.MatchTypeStringEqual, "acme")),
+		record(acl.ActionDeny, acl.OperationRange, []acl.Target{roleTarget(acl.RoleOthers), roleTarget(acl.RoleSystem)},
+			headerFilter(acl.HeaderTypeObject, "", acl.MatchTypeStringNotEqual, ""),
+			headerFilter(acl.HeaderTypeObject, "a=b", acl.MatchTypeNumLT, "#1"),
+			headerFilter(acl.HeaderTypeService, "Ключ", acl.MatchTypeNotPresent, ""),
+			headerFilter(acl.HeaderTypeObject, "x", acl.MatchTypeNumLE, "a=b\n")),
+		record(acl.ActionDeny, acl.OperationSearch, nil),
+	})
+
+	s, err := acl.FormatRules(&table)
+	require.NoError(t, err)
+	require.Equal(t, `version v2.13
+container Ldp
+allow put req:X-Tenant=acme pubkey:03ab,0102
+deny getrange obj:""!="" obj:"a=b"<"#1" svc:!Ключ obj:x<="a=b\n" others system
+deny search
+`, s)
+
+	res, err := acl.ParseRules(s)
+	require.NoError(t, err)
+	require.Equal(t, &table, res)
+
+	for _, r := range []acl.Record{
+		record(acl.ActionUnknown, acl.OperationGet, nil),
+		record(acl.ActionAllow, acl.OperationUnknown, nil),
+		record(acl.ActionAllow, acl.OperationGet, nil, headerFilter(acl.HeaderTypeUnknown, "a", acl.MatchTypeStringEqual, "b")),
+		record(acl.ActionAllow, acl.OperationGet, nil, headerFilter(acl.HeaderTypeObject, "a", acl.MatchTypeUnknown, "b")),
+		record(acl.ActionAllow, acl.OperationGet, nil, headerFilter(acl.HeaderTypeObject, "a", acl.MatchTypeNotPresent, "b")),
+		record(acl.ActionAllow, acl.OperationGet, []acl.Target{roleTarget(acl.RoleUnknown)}),
+		record(acl.ActionAllow, acl.OperationGet, []acl.Target{keysTarget(nil)}),
+	} {
+		table.SetRecords([]acl.Record{r})
+
+		_, err := acl.FormatRules(&table)
+		require.Error(t, err)
+	}
+}
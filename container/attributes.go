@@ -20,6 +20,10 @@ const (
 // SysAttributeZoneDefault is a default value for SysAttributeZone attribute.
 const SysAttributeZoneDefault = "container"
 
+// AttributeTimestamp is a key to the well-known attribute of container
+// creation time in Unix seconds.
+const AttributeTimestamp = "Timestamp"
+
 const disabledHomomorphicHashingValue = "true"
 
 // HomomorphicHashingState returns container's homomorphic
@@ -0,0 +1,333 @@
+package container
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/stable"
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+// Version of the NeoFS API set by BuildPutRequestBody by default.
+const (
+	DefaultVersionMajor = 2
+	DefaultVersionMinor = 14
+)
+
+// NonceSize is a length of the container nonce in bytes: UUID version 4.
+const NonceSize = 16
+
+// Limits of the NNS domain names, see ValidateContainer.
+const (
+	nnsMinDomainLength   = 3
+	nnsMaxDomainLength   = 255
+	nnsMaxFragmentLength = 63
+	nnsMaxRootLength     = 16
+)
+
+// ContainerParams groups parameters of the container created by
+// BuildPutRequestBody.
+type ContainerParams struct {
+	// Version of the NeoFS API. Optional, defaults to DefaultVersionMajor and
+	// DefaultVersionMinor.
+	Version *refs.Version
+
+	// BasicACL of the container. Optional, defaults to acl.BasicACLPrivate.
+	BasicACL acl.BasicACL
+
+	// PlacementPolicy of the container objects. Required.
+	PlacementPolicy *netmap.PlacementPolicy
+
+	// Name is the container name registered in NNS (SysAttributeName).
+	// Optional.
+	Name string
+
+	// Zone is the NNS zone of the container name (SysAttributeZone).
+	// Optional, defaults to SysAttributeZoneDefault if Name is set.
+	Zone string
+
+	// DisableHomomorphicHashing disables homomorphic hashing of the container
+	// objects (SysAttributeHomomorphicHashing). Must agree with the attribute
+	// if it is also set in Attributes.
+	DisableHomomorphicHashing bool
+
+	// Timestamp is the container creation time (AttributeTimestamp).
+	// Optional, the attribute is not set if zero.
+	Timestamp time.Time
+
+	// Attributes are any other container attributes. Optional, placed before
+	// the attributes set by the parameters above.
+	Attributes []Attribute
+}
+
+// BuildPutRequestBody creates a container owned by the key holder with the
+// given parameters and random nonce, checks it using ValidateContainer and
+// returns the request body with the container signed by the key.
+func BuildPutRequestBody(key *ecdsa.PrivateKey, prm ContainerParams) (*PutRequestBody, error) {
+	if key == nil {
+		return nil, errors.New("empty private key")
+	}
+
+	ver := prm.Version
+	if ver == nil {
+		ver = new(refs.Version)
+		ver.SetMajor(DefaultVersionMajor)
+		ver.SetMinor(DefaultVersionMinor)
+	}
+
+	basicACL := prm.BasicACL
+	if basicACL == 0 {
+		basicACL = acl.BasicACLPrivate
+	}
+
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	var owner refs.OwnerID
+	owner.SetValue(ownerid.FromPublicKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y)))
+
+	for i := range prm.Attributes {
+		if prm.Attributes[i].GetKey() != SysAttributeHomomorphicHashing {
+			continue
+		}
+
+		disabled := prm.Attributes[i].GetValue() == disabledHomomorphicHashingValue
+		if disabled != prm.DisableHomomorphicHashing {
+			return nil, fmt.Errorf("attribute %s=%s conflicts with homomorphic hashing disabled=%t",
+				SysAttributeHomomorphicHashing, prm.Attributes[i].GetValue(), prm.DisableHomomorphicHashing)
+		}
+	}
+
+	attrs := make([]Attribute, 0, len(prm.Attributes)+4)
+	attrs = append(attrs, prm.Attributes...)
+
+	if prm.Name != "" {
+		zone := prm.Zone
+		if zone == "" {
+			zone = SysAttributeZoneDefault
+		}
+
+		attrs = appendAttribute(attrs, SysAttributeName, prm.Name)
+		attrs = appendAttribute(attrs, SysAttributeZone, zone)
+	} else if prm.Zone != "" {
+		return nil, errors.New("zone without name")
+	}
+
+	if !prm.Timestamp.IsZero() {
+		attrs = appendAttribute(attrs, AttributeTimestamp, strconv.FormatInt(prm.Timestamp.Unix(), 10))
+	}
+
+	var cnr Container
+	cnr.SetVersion(ver)
+	cnr.SetOwnerID(&owner)
+	cnr.SetNonce(nonce)
+	cnr.SetBasicACL(uint32(basicACL))
+	cnr.SetAttributes(attrs)
+	cnr.SetPlacementPolicy(prm.PlacementPolicy)
+	cnr.SetHomomorphicHashingState(!prm.DisableHomomorphicHashing)
+
+	err = ValidateContainer(&cnr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid container: %w", err)
+	}
+
+	var res PutRequestBody
+	res.SetContainer(&cnr)
+
+	err = signature.SignDataWithHandler(key, stable.Data{M: &cnr}, res.SetSignature, signature.SignWithRFC6979())
+	if err != nil {
+		return nil, fmt.Errorf("sign container: %w", err)
+	}
+
+	return &res, nil
+}
+
+// ValidateContainer checks that the container is well-formed:
+//   - version and owner ID are set;
+//   - nonce is a UUID version 4;
+//   - attribute keys and values are non-empty and keys are unique;
+//   - SysAttributeName and SysAttributeZone form a valid NNS domain name,
+//     the zone is set only together with the name;
+//   - AttributeTimestamp is a base-10 integer;
+//   - placement policy is set and passes netmap.ValidatePlacementPolicy.
+//
+// All found problems are joined into the returned error.
+func ValidateContainer(c *Container) error {
+	if c == nil {
+		return errors.New("missing container")
+	}
+
+	var errs []error
+
+	if c.GetVersion() == nil {
+		errs = append(errs, errors.New("missing version"))
+	}
+
+	if len(c.GetOwnerID().GetValue()) == 0 {
+		errs = append(errs, errors.New("missing owner ID"))
+	}
+
+	if err := validateNonce(c.GetNonce()); err != nil {
+		errs = append(errs, err)
+	}
+
+	attrs := c.GetAttributes()
+	index := make(map[string]string, len(attrs))
+
+	for i := range attrs {
+		key, val := attrs[i].GetKey(), attrs[i].GetValue()
+
+		switch _, ok := index[key]; {
+		case key == "":
+			errs = append(errs, fmt.Errorf("attribute #%d: empty key", i))
+		case val == "":
+			errs = append(errs, fmt.Errorf("attribute %q: empty value", key))
+		case ok:
+			errs = append(errs, fmt.Errorf("duplicated attribute %q", key))
+		default:
+			index[key] = val
+		}
+	}
+
+	name, withName := index[SysAttributeName]
+	zone, withZone := index[SysAttributeZone]
+
+	switch {
+	case withName:
+		if !withZone {
+			zone = SysAttributeZoneDefault
+		}
+
+		if err := validateNNSName(name, zone); err != nil {
+			errs = append(errs, err)
+		}
+	case withZone:
+		errs = append(errs, errors.New("zone without name"))
+	default:
+	}
+
+	if ts, ok := index[AttributeTimestamp]; ok {
+		if _, err := strconv.ParseInt(ts, 10, 64); err != nil {
+			errs = append(errs, fmt.Errorf("invalid timestamp: %w", err))
+		}
+	}
+
+	if p := c.GetPlacementPolicy(); p == nil {
+		errs = append(errs, errors.New("missing placement policy"))
+	} else {
+		for _, issue := range netmap.ValidatePlacementPolicy(p) {
+			errs = append(errs, fmt.Errorf("placement policy: %s", issue))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func appendAttribute(attrs []Attribute, key, val string) []Attribute {
+	var a Attribute
+	a.SetKey(key)
+	a.SetValue(val)
+
+	return append(attrs, a)
+}
+
+// newNonce returns random UUID version 4.
+func newNonce() ([]byte, error) {
+	res := make([]byte, NonceSize)
+
+	_, err := rand.Read(res)
+	if err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	res[6] = res[6]&0x0f | 0x40 // version 4
+	res[8] = res[8]&0x3f | 0x80 // RFC 4122 variant
+
+	return res, nil
+}
+
+func validateNonce(nonce []byte) error {
+	if len(nonce) != NonceSize {
+		return fmt.Errorf("invalid nonce length %d, expected %d", len(nonce), NonceSize)
+	}
+
+	if v := nonce[6] >> 4; v != 4 {
+		return fmt.Errorf("invalid nonce UUID version %d, expected 4", v)
+	}
+
+	if nonce[8]&0xc0 != 0x80 {
+		return errors.New("invalid nonce UUID variant")
+	}
+
+	return nil
+}
+
+// validateNNSName checks that the container name in the zone is a valid NNS
+// domain name: the name is a single fragment, the last zone fragment is the
+// root one.
+func validateNNSName(name, zone string) error {
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("invalid container name %q: dots are not allowed", name)
+	}
+
+	domain := name + "." + zone
+	if l := len(domain); l < nnsMinDomainLength || l > nnsMaxDomainLength {
+		return fmt.Errorf("invalid NNS domain %q: length %d is out of [%d, %d] range", domain, l, nnsMinDomainLength, nnsMaxDomainLength)
+	}
+
+	fragments := strings.Split(domain, ".")
+	for i := range fragments {
+		if !isNNSFragment(fragments[i], i == len(fragments)-1) {
+			return fmt.Errorf("invalid NNS domain %q: invalid fragment %q", domain, fragments[i])
+		}
+	}
+
+	return nil
+}
+
+// isNNSFragment checks the domain name fragment: lowercase letters, digits
+// and inner hyphens, root fragment starts with a letter.
+func isNNSFragment(s string, root bool) bool {
+	maxLen := nnsMaxFragmentLength
+	if root {
+		maxLen = nnsMaxRootLength
+	}
+
+	if len(s) == 0 || len(s) > maxLen {
+		return false
+	}
+
+	if (root && !isLowerLetter(s[0])) || (!isLowerLetter(s[0]) && !isDigit(s[0])) {
+		return false
+	}
+
+	for i := 1; i < len(s)-1; i++ {
+		if s[i] != '-' && !isLowerLetter(s[i]) && !isDigit(s[i]) {
+			return false
+		}
+	}
+
+	last := s[len(s)-1]
+
+	return isLowerLetter(last) || isDigit(last)
+}
+
+func isLowerLetter(c byte) bool {
+	return c >= 'a' && c <= 'z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,189 @@
+package container_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func attribute(key, val string) container.Attribute {
+	var a container.Attribute
+	a.SetKey(key)
+	a.SetValue(val)
+
+	return a
+}
+
+func attributeMap(c *container.Container) map[string]string {
+	res := make(map[string]string)
+	for _, a := range c.GetAttributes() {
+		res[a.GetKey()] = a.GetValue()
+	}
+
+	return res
+}
+
+func TestBuildPutRequestBody(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	policy, err := netmap.ParsePlacementPolicy("REP 2")
+	require.NoError(t, err)
+
+	_, err = container.BuildPutRequestBody(nil, container.ContainerParams{PlacementPolicy: policy})
+	require.Error(t, err)
+
+	_, err = container.BuildPutRequestBody(key, container.ContainerParams{})
+	require.Error(t, err)
+
+	t.Run("defaults", func(t *testing.T) {
+		body, err := container.BuildPutRequestBody(key, container.ContainerParams{PlacementPolicy: policy})
+		require.NoError(t, err)
+
+		cnr := body.GetContainer()
+		require.EqualValues(t, container.DefaultVersionMajor, cnr.GetVersion().GetMajor())
+		require.EqualValues(t, container.DefaultVersionMinor, cnr.GetVersion().GetMinor())
+		require.EqualValues(t, acl.BasicACLPrivate, cnr.GetBasicACL())
+		require.Equal(t, ownerOf(key), cnr.GetOwnerID())
+		require.Len(t, cnr.GetNonce(), container.NonceSize)
+		require.Empty(t, cnr.GetAttributes())
+		require.True(t, cnr.HomomorphicHashingState())
+		require.Equal(t, policy, cnr.GetPlacementPolicy())
+
+		require.NoError(t, container.ValidateContainer(cnr))
+		require.NoError(t, container.VerifySignature(cnr, body.GetSignature()))
+
+		other, err := container.BuildPutRequestBody(key, container.ContainerParams{PlacementPolicy: policy})
+		require.NoError(t, err)
+		require.NotEqual(t, cnr.GetNonce(), other.GetContainer().GetNonce())
+	})
+
+	t.Run("full", func(t *testing.T) {
+		ts := time.Unix(1700000000, 0)
+
+		body, err := container.BuildPutRequestBody(key, container.ContainerParams{
+			BasicACL:                  acl.BasicACLPublicRW,
+			PlacementPolicy:           policy,
+			Name:                      "my-container",
+			Zone:                      "neofs",
+			DisableHomomorphicHashing: true,
+			Timestamp:                 ts,
+			Attributes:                []container.Attribute{attribute("k", "v")},
+		})
+		require.NoError(t, err)
+
+		cnr := body.GetContainer()
+		require.EqualValues(t, acl.BasicACLPublicRW, cnr.GetBasicACL())
+		require.False(t, cnr.HomomorphicHashingState())
+		require.Equal(t, "k", cnr.GetAttributes()[0].GetKey())
+		require.Equal(t, map[string]string{
+			"k":                                      "v",
+			container.SysAttributeName:               "my-container",
+			container.SysAttributeZone:               "neofs",
+			container.AttributeTimestamp:             strconv.FormatInt(ts.Unix(), 10),
+			container.SysAttributeHomomorphicHashing: "true",
+		}, attributeMap(cnr))
+
+		require.NoError(t, container.VerifySignature(cnr, body.GetSignature()))
+	})
+
+	t.Run("default zone", func(t *testing.T) {
+		body, err := container.BuildPutRequestBody(key, container.ContainerParams{PlacementPolicy: policy, Name: "name"})
+		require.NoError(t, err)
+		require.Equal(t, container.SysAttributeZoneDefault, attributeMap(body.GetContainer())[container.SysAttributeZone])
+	})
+
+	t.Run("homomorphic hashing attribute", func(t *testing.T) {
+		attrs := []container.Attribute{attribute(container.SysAttributeHomomorphicHashing, "true")}
+
+		body, err := container.BuildPutRequestBody(key, container.ContainerParams{
+			PlacementPolicy:           policy,
+			DisableHomomorphicHashing: true,
+			Attributes:                attrs,
+		})
+		require.NoError(t, err)
+		require.False(t, body.GetContainer().HomomorphicHashingState())
+
+		_, err = container.BuildPutRequestBody(key, container.ContainerParams{PlacementPolicy: policy, Attributes: attrs})
+		require.Error(t, err)
+
+		_, err = container.BuildPutRequestBody(key, container.ContainerParams{
+			PlacementPolicy:           policy,
+			DisableHomomorphicHashing: true,
+			Attributes:                []container.Attribute{attribute(container.SysAttributeHomomorphicHashing, "false")},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, prm := range []container.ContainerParams{
+			{PlacementPolicy: policy, Zone: "neofs"},
+			{PlacementPolicy: policy, Name: "Upper"},
+			{PlacementPolicy: policy, Name: "a.b"},
+			{PlacementPolicy: policy, Name: "-name"},
+			{PlacementPolicy: policy, Name: "name", Zone: "1zone"},
+			{PlacementPolicy: policy, Attributes: []container.Attribute{attribute(container.SysAttributeName, "name")}, Name: "name"},
+			{PlacementPolicy: new(netmap.PlacementPolicy)},
+		} {
+			_, err := container.BuildPutRequestBody(key, prm)
+			require.Error(t, err, prm)
+		}
+	})
+}
+
+func TestValidateContainer(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	policy, err := netmap.ParsePlacementPolicy("REP 1")
+	require.NoError(t, err)
+
+	newContainer := func() *container.Container {
+		body, err := container.BuildPutRequestBody(key, container.ContainerParams{PlacementPolicy: policy, Name: "name"})
+		require.NoError(t, err)
+
+		return body.GetContainer()
+	}
+
+	require.Error(t, container.ValidateContainer(nil))
+
+	for name, corrupt := range map[string]func(*container.Container){
+		"version":       func(c *container.Container) { c.SetVersion(nil) },
+		"owner":         func(c *container.Container) { c.SetOwnerID(nil) },
+		"nonce length":  func(c *container.Container) { c.SetNonce(c.GetNonce()[1:]) },
+		"nonce version": func(c *container.Container) { c.GetNonce()[6] = 0x10 },
+		"nonce variant": func(c *container.Container) { c.GetNonce()[8] = 0xc0 },
+		"empty key":     func(c *container.Container) { c.SetAttributes(append(c.GetAttributes(), attribute("", "v"))) },
+		"empty value":   func(c *container.Container) { c.SetAttributes(append(c.GetAttributes(), attribute("k", ""))) },
+		"duplicate": func(c *container.Container) {
+			c.SetAttributes(append(c.GetAttributes(), attribute("k", "1"), attribute("k", "2")))
+		},
+		"zone without name": func(c *container.Container) {
+			c.SetAttributes([]container.Attribute{attribute(container.SysAttributeZone, "neofs")})
+		},
+		"long name": func(c *container.Container) {
+			c.SetAttributes([]container.Attribute{attribute(container.SysAttributeName, strings.Repeat("a", 64))})
+		},
+		"timestamp": func(c *container.Container) {
+			c.SetAttributes([]container.Attribute{attribute(container.AttributeTimestamp, "yesterday")})
+		},
+		"policy": func(c *container.Container) { c.SetPlacementPolicy(nil) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := newContainer()
+			require.NoError(t, container.ValidateContainer(c))
+
+			corrupt(c)
+			require.Error(t, container.ValidateContainer(c))
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package container
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/stable"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+// ComputeID returns identifier of the container: SHA-256 hash of its stable
+// encoding.
+func ComputeID(c *Container) *refs.ContainerID {
+	h := sha256.Sum256(c.StableMarshal(nil))
+
+	var id refs.ContainerID
+	id.SetValue(h[:])
+
+	return &id
+}
+
+// VerifyID checks that the identifier corresponds to the container (see
+// ComputeID).
+func VerifyID(c *Container, id *refs.ContainerID) error {
+	if c == nil {
+		return errors.New("missing container")
+	}
+
+	if !bytes.Equal(ComputeID(c).GetValue(), id.GetValue()) {
+		return errors.New("container ID mismatch")
+	}
+
+	return nil
+}
+
+// VerifySignature checks that the signature is a valid RFC 6979 signature of
+// the container stable encoding made by the container owner, i.e. signer's
+// public key corresponds to the owner ID.
+func VerifySignature(c *Container, sig *refs.Signature) error {
+	if c == nil {
+		return errors.New("missing container")
+	}
+
+	return verifyOwnerSignature(c, c.GetOwnerID(), sig)
+}
+
+// VerifyEACLSignature checks that the signature is a valid RFC 6979 signature
+// of the eACL table stable encoding made by the owner of the container the
+// table is set for.
+func VerifyEACLSignature(t *acl.Table, owner *refs.OwnerID, sig *refs.Signature) error {
+	if t == nil {
+		return errors.New("missing eACL table")
+	}
+
+	return verifyOwnerSignature(t, owner, sig)
+}
+
+// VerifySignature checks the signature of the requested container (see
+// VerifySignature function).
+func (r *GetResponseBody) VerifySignature() error {
+	return VerifySignature(r.GetContainer(), r.GetSignature())
+}
+
+// VerifySignature checks the signature of the requested eACL table, owner is
+// the owner of the container the table is set for (see VerifyEACLSignature).
+func (r *GetExtendedACLResponseBody) VerifySignature(owner *refs.OwnerID) error {
+	return VerifyEACLSignature(r.GetEACL(), owner, r.GetSignature())
+}
+
+func verifyOwnerSignature(m stable.Marshaler, owner *refs.OwnerID, sig *refs.Signature) error {
+	if sig == nil {
+		return errors.New("missing signature")
+	}
+
+	// signature scheme is reset by the setters, see SetSignature methods
+	err := signature.VerifyDataWithSource(stable.Data{M: m}, func() *refs.Signature { return sig }, signature.SignWithRFC6979())
+	if err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	if !bytes.Equal(owner.GetValue(), ownerid.FromPublicKey(sig.GetKey())) {
+		return errors.New("owner does not correspond to the signature key")
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package container_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	acltest "github.com/nspcc-dev/neofs-api-go/v2/acl/test"
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	containertest "github.com/nspcc-dev/neofs-api-go/v2/container/test"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	neofssignature "github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+	"github.com/stretchr/testify/require"
+)
+
+func ownerOf(key *ecdsa.PrivateKey) *refs.OwnerID {
+	var res refs.OwnerID
+	res.SetValue(ownerid.FromPublicKey(elliptic.MarshalCompressed(key.Curve, key.X, key.Y)))
+
+	return &res
+}
+
+func TestComputeID(t *testing.T) {
+	cnr := containertest.GenerateContainer(false)
+
+	h := sha256.Sum256(cnr.StableMarshal(nil))
+	id := container.ComputeID(cnr)
+	require.Equal(t, h[:], id.GetValue())
+
+	require.NoError(t, container.VerifyID(cnr, id))
+	require.Error(t, container.VerifyID(nil, id))
+	require.Error(t, container.VerifyID(cnr, nil))
+
+	cnr.SetNonce(append(cnr.GetNonce(), 1))
+	require.Error(t, container.VerifyID(cnr, id))
+}
+
+func TestGetResponseBody_VerifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	other, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	cnr := containertest.GenerateContainer(false)
+	cnr.SetOwnerID(ownerOf(key))
+
+	var body container.GetResponseBody
+	body.SetContainer(cnr)
+
+	require.Error(t, body.VerifySignature())
+
+	sign := func(key *ecdsa.PrivateKey, opts ...signature.SignOption) {
+		require.NoError(t, signature.SignDataWithHandler(key, &neofssignature.StableMarshalerWrapper{SM: cnr}, body.SetSignature, opts...))
+	}
+
+	sign(key, signature.SignWithRFC6979())
+	require.NoError(t, body.VerifySignature())
+	require.NoError(t, container.VerifySignature(cnr, body.GetSignature()))
+
+	t.Run("not RFC 6979", func(t *testing.T) {
+		sign(key)
+		require.Error(t, body.VerifySignature())
+	})
+
+	t.Run("not owner", func(t *testing.T) {
+		sign(other, signature.SignWithRFC6979())
+		require.Error(t, body.VerifySignature())
+	})
+
+	t.Run("changed container", func(t *testing.T) {
+		sign(key, signature.SignWithRFC6979())
+		cnr.SetBasicACL(cnr.GetBasicACL() + 1)
+		require.Error(t, body.VerifySignature())
+	})
+
+	t.Run("missing container", func(t *testing.T) {
+		require.Error(t, container.VerifySignature(nil, body.GetSignature()))
+	})
+}
+
+func TestGetExtendedACLResponseBody_VerifySignature(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	table := acltest.GenerateTable(false)
+
+	var body container.GetExtendedACLResponseBody
+	body.SetEACL(table)
+
+	require.Error(t, body.VerifySignature(ownerOf(key)))
+
+	require.NoError(t, signature.SignDataWithHandler(key, &neofssignature.StableMarshalerWrapper{SM: table}, body.SetSignature, signature.SignWithRFC6979()))
+	require.NoError(t, body.VerifySignature(ownerOf(key)))
+	require.NoError(t, container.VerifyEACLSignature(table, ownerOf(key), body.GetSignature()))
+
+	var another refs.OwnerID
+	another.SetValue([]byte{1, 2, 3})
+	require.Error(t, body.VerifySignature(&another))
+	require.Error(t, body.VerifySignature(nil))
+
+	require.Error(t, container.VerifyEACLSignature(nil, ownerOf(key), body.GetSignature()))
+}
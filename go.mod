@@ -3,8 +3,10 @@ module github.com/nspcc-dev/neofs-api-go/v2
 go 1.20
 
 require (
+	github.com/nspcc-dev/hrw v1.0.9
 	github.com/nspcc-dev/rfc6979 v0.2.1
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/crypto v0.21.0
 	google.golang.org/grpc v1.62.0
 	google.golang.org/protobuf v1.33.0
 )
@@ -15,6 +17,7 @@ require (
 	github.com/kr/pretty v0.3.0 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rogpeppe/go-internal v1.9.0 // indirect
+	github.com/spaolacci/murmur3 v1.1.0 // indirect
 	golang.org/x/net v0.23.0 // indirect
 	golang.org/x/sys v0.18.0 // indirect
 	golang.org/x/text v0.14.0 // indirect
@@ -0,0 +1,84 @@
+// Package base58 implements Base58 encoding with Bitcoin alphabet used
+// for the text representation of NeoFS identifiers.
+package base58
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	bigRadix = big.NewInt(58)
+
+	decodeMap [256]int8
+)
+
+func init() {
+	for i := range decodeMap {
+		decodeMap[i] = -1
+	}
+
+	for i := range alphabet {
+		decodeMap[alphabet[i]] = int8(i)
+	}
+}
+
+// Encode returns Base58 encoding of data.
+func Encode(data []byte) string {
+	var zeros int
+	for zeros < len(data) && data[zeros] == 0 {
+		zeros++
+	}
+
+	var (
+		num = new(big.Int).SetBytes(data)
+		mod = new(big.Int)
+		res = make([]byte, 0, len(data)*138/100+1)
+	)
+
+	for num.Sign() > 0 {
+		num.DivMod(num, bigRadix, mod)
+		res = append(res, alphabet[mod.Int64()])
+	}
+
+	for i := 0; i < zeros; i++ {
+		res = append(res, alphabet[0])
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return string(res)
+}
+
+// Decode decodes Base58 string. Returns an error if s contains characters
+// outside the alphabet.
+func Decode(s string) ([]byte, error) {
+	var (
+		num   = new(big.Int)
+		zeros int
+	)
+
+	for zeros < len(s) && s[zeros] == alphabet[0] {
+		zeros++
+	}
+
+	for i := 0; i < len(s); i++ {
+		d := decodeMap[s[i]]
+		if d < 0 {
+			return nil, fmt.Errorf("invalid character %q at position %d", s[i], i)
+		}
+
+		num.Mul(num, bigRadix)
+		num.Add(num, big.NewInt(int64(d)))
+	}
+
+	b := num.Bytes()
+	res := make([]byte, zeros+len(b))
+	copy(res[zeros:], b)
+
+	return res, nil
+}
@@ -0,0 +1,29 @@
+package base58
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBase58(t *testing.T) {
+	for _, tc := range []struct {
+		data []byte
+		str  string
+	}{
+		{data: []byte{}, str: ""},
+		{data: []byte{0}, str: "1"},
+		{data: []byte{0, 0, 1}, str: "112"},
+		{data: []byte("Hello World!"), str: "2NEpo7TZRRrLZSi2U"},
+		{data: []byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd}, str: "11233QC4"},
+	} {
+		require.Equal(t, tc.str, Encode(tc.data))
+
+		data, err := Decode(tc.str)
+		require.NoError(t, err)
+		require.Equal(t, tc.data, data)
+	}
+
+	_, err := Decode("0OIl")
+	require.Error(t, err)
+}
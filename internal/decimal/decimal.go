@@ -0,0 +1,21 @@
+// Package decimal provides comparison of the arbitrary-precision decimal
+// integers used by the numeric matchers of the NeoFS API filters.
+package decimal
+
+import "math/big"
+
+// Compare parses a and b as base-10 integers and compares them like
+// big.Int.Cmp. Returns false if any of the numbers is invalid.
+func Compare(a, b string) (int, bool) {
+	n1, ok := new(big.Int).SetString(a, 10)
+	if !ok {
+		return 0, false
+	}
+
+	n2, ok := new(big.Int).SetString(b, 10)
+	if !ok {
+		return 0, false
+	}
+
+	return n1.Cmp(n2), true
+}
@@ -0,0 +1,28 @@
+package decimal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		a, b string
+		res  int
+	}{
+		{a: "1", b: "2", res: -1},
+		{a: "2", b: "1", res: 1},
+		{a: "-5", b: "-5", res: 0},
+		{a: "18446744073709551616", b: "18446744073709551615", res: 1},
+	} {
+		res, ok := Compare(tc.a, tc.b)
+		require.True(t, ok, tc)
+		require.Equal(t, tc.res, res, tc)
+	}
+
+	for _, tc := range [][2]string{{"", "1"}, {"1", "x"}, {"1.5", "1"}, {"0x10", "16"}} {
+		_, ok := Compare(tc[0], tc[1])
+		require.False(t, ok, tc)
+	}
+}
@@ -0,0 +1,50 @@
+// Package lex provides lexing routines shared by the text parsers of the NeoFS
+// API structures.
+package lex
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// QuotedString reads double-quoted Go string literal from the beginning of s
+// and returns its unquoted value and the literal length in bytes.
+func QuotedString(s string) (string, int, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return "", 0, errors.New("expected string literal")
+	}
+
+	end := 1
+	for ; end < len(s) && s[end] != '"'; end++ {
+		if s[end] == '\\' {
+			end++
+		}
+	}
+
+	if end >= len(s) {
+		return "", 0, errors.New("unterminated string")
+	}
+
+	res, err := strconv.Unquote(s[:end+1])
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid string literal: %w", err)
+	}
+
+	return res, end + 1, nil
+}
+
+// Operator returns index of the longest of n operators which s starts with,
+// or -1 if there is no such operator. The i-th operator is returned by op.
+func Operator(s string, n int, op func(i int) string) int {
+	res := -1
+
+	for i := 0; i < n; i++ {
+		if o := op(i); strings.HasPrefix(s, o) && (res < 0 || len(o) > len(op(res))) {
+			res = i
+		}
+	}
+
+	return res
+}
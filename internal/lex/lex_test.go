@@ -0,0 +1,42 @@
+package lex
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotedString(t *testing.T) {
+	for _, tc := range []struct {
+		src string
+		res string
+		n   int
+	}{
+		{src: `""`, res: "", n: 2},
+		{src: `"abc" tail`, res: "abc", n: 5},
+		{src: `"a \"b\"" tail`, res: `a "b"`, n: 9},
+		{src: `"é"`, res: "é", n: 4},
+	} {
+		res, n, err := QuotedString(tc.src)
+		require.NoError(t, err, tc.src)
+		require.Equal(t, tc.res, res, tc.src)
+		require.Equal(t, tc.n, n, tc.src)
+	}
+
+	for _, src := range []string{``, `abc`, `"abc`, `"abc\"`, `"\q"`} {
+		_, _, err := QuotedString(src)
+		require.Error(t, err, src)
+	}
+}
+
+func TestOperator(t *testing.T) {
+	ops := []string{"=", "<", "<=", "!="}
+	op := func(i int) string { return ops[i] }
+
+	require.Equal(t, 0, Operator("=1", len(ops), op))
+	require.Equal(t, 1, Operator("<1", len(ops), op))
+	require.Equal(t, 2, Operator("<=1", len(ops), op))
+	require.Equal(t, 3, Operator("!=1", len(ops), op))
+	require.Equal(t, -1, Operator("!1", len(ops), op))
+	require.Equal(t, -1, Operator("", len(ops), op))
+}
@@ -0,0 +1,49 @@
+// Package ownerid implements derivation of NeoFS owner IDs from public keys.
+package ownerid
+
+import (
+	"crypto/sha256"
+
+	"golang.org/x/crypto/ripemd160" //nolint:staticcheck // NEO3 addresses are defined with RIPEMD-160
+)
+
+// Size is a length of the owner ID in bytes.
+const Size = 1 + ripemd160.Size + checksumLen
+
+const (
+	// NEO3 address version byte.
+	addressVersion = 0x35
+
+	checksumLen = 4
+
+	compressedKeyLen = 33
+)
+
+// FromPublicKey returns owner ID corresponding to the compressed public key:
+// NEO3 address of the standard single signature verification script. Returns
+// nil if the key is not 33 bytes long.
+func FromPublicKey(key []byte) []byte {
+	if len(key) != compressedKeyLen {
+		return nil
+	}
+
+	// PUSHDATA1 33 <key> SYSCALL System.Crypto.CheckSig
+	script := make([]byte, 0, 2+compressedKeyLen+5)
+	script = append(script, 0x0c, compressedKeyLen)
+	script = append(script, key...)
+	script = append(script, 0x41, 0x56, 0xe7, 0xb3, 0x27)
+
+	h := sha256.Sum256(script)
+	rh := ripemd160.New()
+	rh.Write(h[:])
+	scriptHash := rh.Sum(nil)
+
+	res := make([]byte, 0, Size)
+	res = append(res, addressVersion)
+	res = append(res, scriptHash...)
+
+	h = sha256.Sum256(res)
+	h = sha256.Sum256(h[:])
+
+	return append(res, h[:checksumLen]...)
+}
@@ -0,0 +1,20 @@
+package ownerid
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/base58"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromPublicKey(t *testing.T) {
+	key, err := hex.DecodeString("03b209fd4f53a7170ea4444e0cb0a6bb6a53c2bd016926989cf85f9b0fba17a70c")
+	require.NoError(t, err)
+
+	id := FromPublicKey(key)
+	require.Len(t, id, Size)
+	require.Equal(t, "NZeAarn3UMCqNsTymTMF2Pn6X7Yw3GhqDv", base58.Encode(id))
+
+	require.Nil(t, FromPublicKey(key[1:]))
+}
@@ -0,0 +1,25 @@
+// Package stable provides signature data sources of the stable encoded NeoFS
+// API messages for the packages which can not use
+// signature.StableMarshalerWrapper because of import cycles.
+package stable
+
+// Marshaler is a message with the stable binary encoding.
+type Marshaler interface {
+	StableMarshal([]byte) []byte
+	StableSize() int
+}
+
+// Data is a util/signature.DataSource of the stable encoded message.
+type Data struct {
+	M Marshaler
+}
+
+// ReadSignedData encodes the message into buf.
+func (x Data) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.M.StableMarshal(buf), nil
+}
+
+// SignedDataSize returns size of the encoded message.
+func (x Data) SignedDataSize() int {
+	return x.M.StableSize()
+}
@@ -0,0 +1,20 @@
+package stable_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/stable"
+	refstest "github.com/nspcc-dev/neofs-api-go/v2/refs/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestData(t *testing.T) {
+	id := refstest.GenerateObjectID(false)
+	d := stable.Data{M: id}
+
+	require.Equal(t, id.StableSize(), d.SignedDataSize())
+
+	data, err := d.ReadSignedData(make([]byte, d.SignedDataSize()))
+	require.NoError(t, err)
+	require.Equal(t, id.StableMarshal(nil), data)
+}
@@ -0,0 +1,307 @@
+package netmap
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Keys to the well-known network parameters of NetworkConfig.
+const (
+	NetworkParamMaxObjectSize              = "MaxObjectSize"
+	NetworkParamBasicIncomeRate            = "BasicIncomeRate"
+	NetworkParamAuditFee                   = "AuditFee"
+	NetworkParamEpochDuration              = "EpochDuration"
+	NetworkParamContainerFee               = "ContainerFee"
+	NetworkParamContainerAliasFee          = "ContainerAliasFee"
+	NetworkParamEigenTrustIterations       = "EigenTrustIterations"
+	NetworkParamEigenTrustAlpha            = "EigenTrustAlpha"
+	NetworkParamInnerRingCandidateFee      = "InnerRingCandidateFee"
+	NetworkParamWithdrawFee                = "WithdrawFee"
+	NetworkParamHomomorphicHashingDisabled = "HomomorphicHashingDisabled"
+	NetworkParamMaintenanceModeAllowed     = "MaintenanceModeAllowed"
+)
+
+// Typed accessors of the well-known network parameters. Integer parameters
+// are little-endian unsigned integers of up to 8 bytes (setters always write
+// 8 bytes), boolean ones are true if any byte is non-zero (setters write a
+// single byte), EigenTrustAlpha is a decimal string of a float in [0, 1].
+//
+// Getters return zero value if the parameter is not set and an error if it is
+// set more than once or has invalid value. Setters replace value of the first
+// parameter with the key and remove the others or append a new parameter,
+// order of the other parameters is kept.
+
+// GetMaxObjectSize returns value of the NetworkParamMaxObjectSize parameter.
+func (x *NetworkConfig) GetMaxObjectSize() (uint64, error) {
+	return x.getUint(NetworkParamMaxObjectSize)
+}
+
+// SetMaxObjectSize sets value of the NetworkParamMaxObjectSize parameter.
+func (x *NetworkConfig) SetMaxObjectSize(v uint64) {
+	x.setUint(NetworkParamMaxObjectSize, v)
+}
+
+// GetBasicIncomeRate returns value of the NetworkParamBasicIncomeRate
+// parameter.
+func (x *NetworkConfig) GetBasicIncomeRate() (uint64, error) {
+	return x.getUint(NetworkParamBasicIncomeRate)
+}
+
+// SetBasicIncomeRate sets value of the NetworkParamBasicIncomeRate parameter.
+func (x *NetworkConfig) SetBasicIncomeRate(v uint64) {
+	x.setUint(NetworkParamBasicIncomeRate, v)
+}
+
+// GetAuditFee returns value of the NetworkParamAuditFee parameter.
+func (x *NetworkConfig) GetAuditFee() (uint64, error) {
+	return x.getUint(NetworkParamAuditFee)
+}
+
+// SetAuditFee sets value of the NetworkParamAuditFee parameter.
+func (x *NetworkConfig) SetAuditFee(v uint64) {
+	x.setUint(NetworkParamAuditFee, v)
+}
+
+// GetEpochDuration returns value of the NetworkParamEpochDuration parameter.
+func (x *NetworkConfig) GetEpochDuration() (uint64, error) {
+	return x.getUint(NetworkParamEpochDuration)
+}
+
+// SetEpochDuration sets value of the NetworkParamEpochDuration parameter.
+func (x *NetworkConfig) SetEpochDuration(v uint64) {
+	x.setUint(NetworkParamEpochDuration, v)
+}
+
+// GetContainerFee returns value of the NetworkParamContainerFee parameter.
+func (x *NetworkConfig) GetContainerFee() (uint64, error) {
+	return x.getUint(NetworkParamContainerFee)
+}
+
+// SetContainerFee sets value of the NetworkParamContainerFee parameter.
+func (x *NetworkConfig) SetContainerFee(v uint64) {
+	x.setUint(NetworkParamContainerFee, v)
+}
+
+// GetContainerAliasFee returns value of the NetworkParamContainerAliasFee
+// parameter.
+func (x *NetworkConfig) GetContainerAliasFee() (uint64, error) {
+	return x.getUint(NetworkParamContainerAliasFee)
+}
+
+// SetContainerAliasFee sets value of the NetworkParamContainerAliasFee
+// parameter.
+func (x *NetworkConfig) SetContainerAliasFee(v uint64) {
+	x.setUint(NetworkParamContainerAliasFee, v)
+}
+
+// GetEigenTrustIterations returns value of the
+// NetworkParamEigenTrustIterations parameter.
+func (x *NetworkConfig) GetEigenTrustIterations() (uint64, error) {
+	return x.getUint(NetworkParamEigenTrustIterations)
+}
+
+// SetEigenTrustIterations sets value of the NetworkParamEigenTrustIterations
+// parameter.
+func (x *NetworkConfig) SetEigenTrustIterations(v uint64) {
+	x.setUint(NetworkParamEigenTrustIterations, v)
+}
+
+// GetEigenTrustAlpha returns value of the NetworkParamEigenTrustAlpha
+// parameter.
+func (x *NetworkConfig) GetEigenTrustAlpha() (float64, error) {
+	val, err := x.getParam(NetworkParamEigenTrustAlpha)
+	if err != nil || val == nil {
+		return 0, err
+	}
+
+	res, err := strconv.ParseFloat(string(val), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid network parameter %s: %w", NetworkParamEigenTrustAlpha, err)
+	}
+
+	if math.IsNaN(res) || res < 0 || res > 1 {
+		return 0, fmt.Errorf("invalid network parameter %s: %v is out of [0, 1] range", NetworkParamEigenTrustAlpha, res)
+	}
+
+	return res, nil
+}
+
+// SetEigenTrustAlpha sets value of the NetworkParamEigenTrustAlpha parameter.
+func (x *NetworkConfig) SetEigenTrustAlpha(v float64) {
+	x.setParam(NetworkParamEigenTrustAlpha, []byte(strconv.FormatFloat(v, 'f', -1, 64)))
+}
+
+// GetInnerRingCandidateFee returns value of the
+// NetworkParamInnerRingCandidateFee parameter.
+func (x *NetworkConfig) GetInnerRingCandidateFee() (uint64, error) {
+	return x.getUint(NetworkParamInnerRingCandidateFee)
+}
+
+// SetInnerRingCandidateFee sets value of the NetworkParamInnerRingCandidateFee
+// parameter.
+func (x *NetworkConfig) SetInnerRingCandidateFee(v uint64) {
+	x.setUint(NetworkParamInnerRingCandidateFee, v)
+}
+
+// GetWithdrawFee returns value of the NetworkParamWithdrawFee parameter.
+func (x *NetworkConfig) GetWithdrawFee() (uint64, error) {
+	return x.getUint(NetworkParamWithdrawFee)
+}
+
+// SetWithdrawFee sets value of the NetworkParamWithdrawFee parameter.
+func (x *NetworkConfig) SetWithdrawFee(v uint64) {
+	x.setUint(NetworkParamWithdrawFee, v)
+}
+
+// GetHomomorphicHashingDisabled returns value of the
+// NetworkParamHomomorphicHashingDisabled parameter.
+func (x *NetworkConfig) GetHomomorphicHashingDisabled() (bool, error) {
+	return x.getBool(NetworkParamHomomorphicHashingDisabled)
+}
+
+// SetHomomorphicHashingDisabled sets value of the
+// NetworkParamHomomorphicHashingDisabled parameter.
+func (x *NetworkConfig) SetHomomorphicHashingDisabled(v bool) {
+	x.setBool(NetworkParamHomomorphicHashingDisabled, v)
+}
+
+// GetMaintenanceModeAllowed returns value of the
+// NetworkParamMaintenanceModeAllowed parameter.
+func (x *NetworkConfig) GetMaintenanceModeAllowed() (bool, error) {
+	return x.getBool(NetworkParamMaintenanceModeAllowed)
+}
+
+// SetMaintenanceModeAllowed sets value of the
+// NetworkParamMaintenanceModeAllowed parameter.
+func (x *NetworkConfig) SetMaintenanceModeAllowed(v bool) {
+	x.setBool(NetworkParamMaintenanceModeAllowed, v)
+}
+
+// IsKnownNetworkParameter checks if the key is a key to one of the
+// well-known network parameters having typed accessors.
+func IsKnownNetworkParameter(key string) bool {
+	switch key {
+	default:
+		return false
+	case NetworkParamMaxObjectSize,
+		NetworkParamBasicIncomeRate,
+		NetworkParamAuditFee,
+		NetworkParamEpochDuration,
+		NetworkParamContainerFee,
+		NetworkParamContainerAliasFee,
+		NetworkParamEigenTrustIterations,
+		NetworkParamEigenTrustAlpha,
+		NetworkParamInnerRingCandidateFee,
+		NetworkParamWithdrawFee,
+		NetworkParamHomomorphicHashingDisabled,
+		NetworkParamMaintenanceModeAllowed:
+		return true
+	}
+}
+
+// getParam returns value of the parameter, nil if it is not set.
+func (x *NetworkConfig) getParam(key string) ([]byte, error) {
+	if x == nil {
+		return nil, nil
+	}
+
+	var (
+		res   []byte
+		found bool
+	)
+
+	for i := range x.ps {
+		if string(x.ps[i].GetKey()) != key {
+			continue
+		}
+
+		if found {
+			return nil, fmt.Errorf("duplicated network parameter %s", key)
+		}
+
+		res, found = x.ps[i].GetValue(), true
+		if res == nil {
+			res = []byte{}
+		}
+	}
+
+	return res, nil
+}
+
+func (x *NetworkConfig) getUint(key string) (uint64, error) {
+	val, err := x.getParam(key)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(val) > 8 {
+		return 0, fmt.Errorf("invalid network parameter %s: %d bytes exceed uint64", key, len(val))
+	}
+
+	var buf [8]byte
+	copy(buf[:], val)
+
+	return binary.LittleEndian.Uint64(buf[:]), nil
+}
+
+func (x *NetworkConfig) getBool(key string) (bool, error) {
+	val, err := x.getParam(key)
+	if err != nil {
+		return false, err
+	}
+
+	for i := range val {
+		if val[i] != 0 {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (x *NetworkConfig) setUint(key string, v uint64) {
+	val := make([]byte, 8)
+	binary.LittleEndian.PutUint64(val, v)
+
+	x.setParam(key, val)
+}
+
+func (x *NetworkConfig) setBool(key string, v bool) {
+	val := []byte{0}
+	if v {
+		val[0] = 1
+	}
+
+	x.setParam(key, val)
+}
+
+func (x *NetworkConfig) setParam(key string, val []byte) {
+	res := make([]NetworkParameter, 0, len(x.ps)+1)
+	found := false
+
+	for i := range x.ps {
+		if string(x.ps[i].GetKey()) != key {
+			res = append(res, x.ps[i])
+			continue
+		}
+
+		if !found {
+			found = true
+
+			res = append(res, x.ps[i])
+			res[len(res)-1].SetValue(val)
+		}
+	}
+
+	if !found {
+		var p NetworkParameter
+		p.SetKey([]byte(key))
+		p.SetValue(val)
+
+		res = append(res, p)
+	}
+
+	x.SetParameters(res...)
+}
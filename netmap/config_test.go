@@ -0,0 +1,144 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func testNetworkParameter(k string, v []byte) netmap.NetworkParameter {
+	var p netmap.NetworkParameter
+	p.SetKey([]byte(k))
+	p.SetValue(v)
+
+	return p
+}
+
+func TestNetworkConfig_Parameters(t *testing.T) {
+	var c netmap.NetworkConfig
+
+	c.SetParameters(
+		testNetworkParameter("Unknown", []byte("unknown")),
+		testNetworkParameter(netmap.NetworkParamMaxObjectSize, []byte{0x00, 0x00, 0x00, 0x04}),
+		testNetworkParameter(netmap.NetworkParamEpochDuration, []byte{0xf0}),
+		testNetworkParameter(netmap.NetworkParamAuditFee, nil),
+		testNetworkParameter(netmap.NetworkParamEigenTrustAlpha, []byte("0.1")),
+		testNetworkParameter(netmap.NetworkParamHomomorphicHashingDisabled, []byte{0, 0, 1}),
+		testNetworkParameter(netmap.NetworkParamMaintenanceModeAllowed, []byte{0}),
+		testNetworkParameter("Unknown2", nil),
+	)
+
+	for _, tc := range []struct {
+		get func() (uint64, error)
+		exp uint64
+	}{
+		{c.GetMaxObjectSize, 64 << 20},
+		{c.GetEpochDuration, 240},
+		{c.GetAuditFee, 0},
+		{c.GetBasicIncomeRate, 0},
+		{c.GetContainerFee, 0},
+	} {
+		v, err := tc.get()
+		require.NoError(t, err)
+		require.Equal(t, tc.exp, v)
+	}
+
+	alpha, err := c.GetEigenTrustAlpha()
+	require.NoError(t, err)
+	require.Equal(t, 0.1, alpha)
+
+	b, err := c.GetHomomorphicHashingDisabled()
+	require.NoError(t, err)
+	require.True(t, b)
+
+	b, err = c.GetMaintenanceModeAllowed()
+	require.NoError(t, err)
+	require.False(t, b)
+
+	c.SetMaxObjectSize(1 << 40)
+	c.SetEigenTrustAlpha(0.25)
+	c.SetMaintenanceModeAllowed(true)
+	c.SetWithdrawFee(100)
+
+	var keys []string
+	c.IterateParameters(func(p *netmap.NetworkParameter) bool {
+		keys = append(keys, string(p.GetKey()))
+		return false
+	})
+
+	require.Equal(t, []string{
+		"Unknown",
+		netmap.NetworkParamMaxObjectSize,
+		netmap.NetworkParamEpochDuration,
+		netmap.NetworkParamAuditFee,
+		netmap.NetworkParamEigenTrustAlpha,
+		netmap.NetworkParamHomomorphicHashingDisabled,
+		netmap.NetworkParamMaintenanceModeAllowed,
+		"Unknown2",
+		netmap.NetworkParamWithdrawFee,
+	}, keys)
+
+	values := make(map[string][]byte)
+	c.IterateParameters(func(p *netmap.NetworkParameter) bool {
+		values[string(p.GetKey())] = p.GetValue()
+		return false
+	})
+
+	require.Equal(t, []byte("unknown"), values["Unknown"])
+	require.Equal(t, []byte{0, 0, 0, 0, 0, 1, 0, 0}, values[netmap.NetworkParamMaxObjectSize])
+	require.Equal(t, []byte("0.25"), values[netmap.NetworkParamEigenTrustAlpha])
+	require.Equal(t, []byte{1}, values[netmap.NetworkParamMaintenanceModeAllowed])
+	require.Equal(t, []byte{100, 0, 0, 0, 0, 0, 0, 0}, values[netmap.NetworkParamWithdrawFee])
+
+	v, err := c.GetMaxObjectSize()
+	require.NoError(t, err)
+	require.EqualValues(t, 1<<40, v)
+
+	// setters remove duplicates
+	c.SetParameters(
+		testNetworkParameter(netmap.NetworkParamContainerFee, []byte{1}),
+		testNetworkParameter("Unknown", nil),
+		testNetworkParameter(netmap.NetworkParamContainerFee, []byte{2}),
+	)
+
+	_, err = c.GetContainerFee()
+	require.Error(t, err)
+
+	c.SetContainerFee(3)
+	require.Equal(t, 2, c.NumberOfParameters())
+
+	v, err = c.GetContainerFee()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, v)
+
+	// nil config has no parameters
+	v, err = (*netmap.NetworkConfig)(nil).GetEpochDuration()
+	require.NoError(t, err)
+	require.Zero(t, v)
+}
+
+func TestNetworkConfig_InvalidParameters(t *testing.T) {
+	for _, p := range []netmap.NetworkParameter{
+		testNetworkParameter(netmap.NetworkParamMaxObjectSize, make([]byte, 9)),
+		testNetworkParameter(netmap.NetworkParamEigenTrustAlpha, []byte("alpha")),
+		testNetworkParameter(netmap.NetworkParamEigenTrustAlpha, []byte("1.5")),
+		testNetworkParameter(netmap.NetworkParamEigenTrustAlpha, []byte("NaN")),
+	} {
+		var c netmap.NetworkConfig
+		c.SetParameters(p)
+
+		var err error
+
+		if string(p.GetKey()) == netmap.NetworkParamMaxObjectSize {
+			_, err = c.GetMaxObjectSize()
+		} else {
+			_, err = c.GetEigenTrustAlpha()
+		}
+
+		require.Error(t, err, string(p.GetValue()))
+	}
+
+	require.True(t, netmap.IsKnownNetworkParameter(netmap.NetworkParamWithdrawFee))
+	require.False(t, netmap.IsKnownNetworkParameter("Unknown"))
+}
@@ -0,0 +1,202 @@
+package netmap
+
+// AttributeChange describes change of the node attribute.
+type AttributeChange struct {
+	// Key of the attribute.
+	Key string
+
+	// Old and New are the attribute values before and after the change. Old
+	// is empty for the added attributes, New is empty for the removed ones.
+	Old, New string
+
+	// Added and Removed are set if the attribute is missing in the old and
+	// new node info respectively.
+	Added, Removed bool
+}
+
+// NodeChange describes changes of the node present in both network maps.
+type NodeChange struct {
+	// PublicKey of the node.
+	PublicKey []byte
+
+	// OldState and NewState are the node states before and after the change,
+	// equal if the state is not changed.
+	OldState, NewState NodeState
+
+	// OldAddresses and NewAddresses are the node network addresses before and
+	// after the change, both nil if the addresses are not changed.
+	OldAddresses, NewAddresses []string
+
+	// Attributes lists changed attributes: existing attributes in the new
+	// node info order, then removed attributes in the old node info order.
+	Attributes []AttributeChange
+}
+
+// StateChanged checks if the node state is changed.
+func (x NodeChange) StateChanged() bool {
+	return x.OldState != x.NewState
+}
+
+// AddressesChanged checks if the node network addresses (or their order) are
+// changed.
+func (x NodeChange) AddressesChanged() bool {
+	return x.OldAddresses != nil || x.NewAddresses != nil
+}
+
+// NetMapDiff describes changes of the network map between two snapshots.
+type NetMapDiff struct {
+	// OldEpoch and NewEpoch are the epochs of the compared network maps.
+	OldEpoch, NewEpoch uint64
+
+	// Added lists nodes missing in the old network map in the new map order.
+	Added []NodeInfo
+
+	// Removed lists nodes missing in the new network map in the old map
+	// order.
+	Removed []NodeInfo
+
+	// Changed lists nodes present in both network maps with changed state,
+	// addresses or attributes in the new map order.
+	Changed []NodeChange
+}
+
+// Empty checks if the network maps have the same nodes with the same
+// parameters. Epochs are not compared.
+func (x NetMapDiff) Empty() bool {
+	return len(x.Added) == 0 && len(x.Removed) == 0 && len(x.Changed) == 0
+}
+
+// DiffNetMaps compares two network map snapshots, nil network map has no
+// nodes. Nodes are identified by public keys, only the first node with the
+// particular key is taken into account. The same applies to the node
+// attributes with the same key.
+func DiffNetMaps(oldMap, newMap *NetMap) NetMapDiff {
+	res := NetMapDiff{
+		OldEpoch: oldMap.Epoch(),
+		NewEpoch: newMap.Epoch(),
+	}
+
+	oldNodes, newNodes := oldMap.Nodes(), newMap.Nodes()
+	oldIndex, newIndex := indexNodes(oldNodes), indexNodes(newNodes)
+
+	for i := range newNodes {
+		key := string(newNodes[i].GetPublicKey())
+		if newIndex[key] != i {
+			continue
+		}
+
+		j, ok := oldIndex[key]
+		if !ok {
+			res.Added = append(res.Added, newNodes[i])
+			continue
+		}
+
+		if c, changed := diffNodes(&oldNodes[j], &newNodes[i]); changed {
+			res.Changed = append(res.Changed, c)
+		}
+	}
+
+	for i := range oldNodes {
+		key := string(oldNodes[i].GetPublicKey())
+		if oldIndex[key] != i {
+			continue
+		}
+
+		if _, ok := newIndex[key]; !ok {
+			res.Removed = append(res.Removed, oldNodes[i])
+		}
+	}
+
+	return res
+}
+
+// indexNodes returns indices of the first nodes with the particular public
+// key.
+func indexNodes(ns []NodeInfo) map[string]int {
+	res := make(map[string]int, len(ns))
+
+	for i := range ns {
+		key := string(ns[i].GetPublicKey())
+		if _, ok := res[key]; !ok {
+			res[key] = i
+		}
+	}
+
+	return res
+}
+
+func diffNodes(oldNode, newNode *NodeInfo) (NodeChange, bool) {
+	res := NodeChange{
+		PublicKey: newNode.GetPublicKey(),
+		OldState:  oldNode.GetState(),
+		NewState:  newNode.GetState(),
+	}
+
+	oldAddrs, newAddrs := nodeAddresses(oldNode), nodeAddresses(newNode)
+
+	if len(oldAddrs) != len(newAddrs) {
+		res.OldAddresses, res.NewAddresses = oldAddrs, newAddrs
+	} else {
+		for i := range oldAddrs {
+			if oldAddrs[i] != newAddrs[i] {
+				res.OldAddresses, res.NewAddresses = oldAddrs, newAddrs
+				break
+			}
+		}
+	}
+
+	oldAttrs, newAttrs := oldNode.GetAttributes(), newNode.GetAttributes()
+	oldIndex, newIndex := indexAttributes(oldAttrs), indexAttributes(newAttrs)
+
+	for i := range newAttrs {
+		key, val := newAttrs[i].GetKey(), newAttrs[i].GetValue()
+		if newIndex[key] != i {
+			continue
+		}
+
+		switch j, ok := oldIndex[key]; {
+		case !ok:
+			res.Attributes = append(res.Attributes, AttributeChange{Key: key, New: val, Added: true})
+		case oldAttrs[j].GetValue() != val:
+			res.Attributes = append(res.Attributes, AttributeChange{Key: key, Old: oldAttrs[j].GetValue(), New: val})
+		}
+	}
+
+	for i := range oldAttrs {
+		key := oldAttrs[i].GetKey()
+		if oldIndex[key] != i {
+			continue
+		}
+
+		if _, ok := newIndex[key]; !ok {
+			res.Attributes = append(res.Attributes, AttributeChange{Key: key, Old: oldAttrs[i].GetValue(), Removed: true})
+		}
+	}
+
+	return res, res.StateChanged() || res.AddressesChanged() || len(res.Attributes) != 0
+}
+
+func nodeAddresses(n *NodeInfo) []string {
+	res := make([]string, 0, n.NumberOfAddresses())
+
+	n.IterateAddresses(func(addr string) bool {
+		res = append(res, addr)
+		return false
+	})
+
+	return res
+}
+
+// indexAttributes returns indices of the first attributes with the
+// particular key.
+func indexAttributes(as []Attribute) map[string]int {
+	res := make(map[string]int, len(as))
+
+	for i := range as {
+		if _, ok := res[as[i].GetKey()]; !ok {
+			res[as[i].GetKey()] = i
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,83 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffNetMaps(t *testing.T) {
+	n1 := testNode(1, "Country", "RU", "City", "Moscow")
+	n1.SetAddresses("/dns4/n1/tcp/8080")
+	n2 := testNode(2, "Country", "DE")
+	n3 := testNode(3)
+	n4 := testNode(4)
+
+	var oldMap netmap.NetMap
+	oldMap.SetEpoch(10)
+	oldMap.SetNodes([]netmap.NodeInfo{n1, n2, n3})
+
+	require.True(t, netmap.DiffNetMaps(&oldMap, &oldMap).Empty())
+
+	n1New := testNode(1, "City", "Saint Petersburg", "Country", "RU", "Price", "5")
+	n1New.SetAddresses("/dns4/n1/tcp/8080", "/dns4/n1/tcp/8081")
+	n2New := testNode(2, "Country", "DE")
+	n2New.SetState(netmap.Maintenance)
+
+	var newMap netmap.NetMap
+	newMap.SetEpoch(11)
+	newMap.SetNodes([]netmap.NodeInfo{n4, n2New, n1New, n3})
+
+	d := netmap.DiffNetMaps(&oldMap, &newMap)
+	require.False(t, d.Empty())
+	require.EqualValues(t, 10, d.OldEpoch)
+	require.EqualValues(t, 11, d.NewEpoch)
+	require.Equal(t, []netmap.NodeInfo{n4}, d.Added)
+	require.Empty(t, d.Removed)
+	require.Equal(t, []netmap.NodeChange{
+		{
+			PublicKey: []byte{2},
+			OldState:  netmap.Online,
+			NewState:  netmap.Maintenance,
+		},
+		{
+			PublicKey:    []byte{1},
+			OldState:     netmap.Online,
+			NewState:     netmap.Online,
+			OldAddresses: []string{"/dns4/n1/tcp/8080"},
+			NewAddresses: []string{"/dns4/n1/tcp/8080", "/dns4/n1/tcp/8081"},
+			Attributes: []netmap.AttributeChange{
+				{Key: "City", Old: "Moscow", New: "Saint Petersburg"},
+				{Key: "Price", New: "5", Added: true},
+			},
+		},
+	}, d.Changed)
+
+	require.True(t, d.Changed[0].StateChanged())
+	require.False(t, d.Changed[0].AddressesChanged())
+	require.False(t, d.Changed[1].StateChanged())
+	require.True(t, d.Changed[1].AddressesChanged())
+
+	d = netmap.DiffNetMaps(&newMap, &oldMap)
+	require.Equal(t, []netmap.NodeInfo{n4}, d.Removed)
+	require.Empty(t, d.Added)
+	require.Len(t, d.Changed, 2)
+	require.Equal(t, []netmap.AttributeChange{
+		{Key: "City", Old: "Saint Petersburg", New: "Moscow"},
+		{Key: "Price", Old: "5", Removed: true},
+	}, d.Changed[0].Attributes)
+
+	d = netmap.DiffNetMaps(nil, &oldMap)
+	require.Equal(t, oldMap.Nodes(), d.Added)
+	require.Zero(t, d.OldEpoch)
+
+	// duplicated nodes and attributes are ignored
+	dup := testNode(1, "Country", "RU", "City", "Moscow", "City", "Kazan")
+	dup.SetAddresses("/dns4/n1/tcp/8080")
+
+	var dupMap netmap.NetMap
+	dupMap.SetNodes([]netmap.NodeInfo{n1, n2, n3, n2New, dup})
+
+	require.True(t, netmap.DiffNetMaps(&oldMap, &dupMap).Empty())
+}
@@ -0,0 +1,121 @@
+package netmap
+
+import (
+	"fmt"
+)
+
+// ValidateAttributeParents checks that the node attribute hierarchy is
+// consistent: attribute keys are unique, each parent key is a key of another
+// node attribute and there are no cycles.
+func ValidateAttributeParents(node *NodeInfo) error {
+	attrs := node.GetAttributes()
+	index := make(map[string]int, len(attrs))
+
+	for i := range attrs {
+		key := attrs[i].GetKey()
+
+		if _, ok := index[key]; ok {
+			return fmt.Errorf("duplicated attribute %q", key)
+		}
+
+		index[key] = i
+	}
+
+	for i := range attrs {
+		for _, p := range attrs[i].GetParents() {
+			if _, ok := index[p]; !ok {
+				return fmt.Errorf("attribute %q: missing parent %q", attrs[i].GetKey(), p)
+			}
+		}
+	}
+
+	// colors for the depth-first search: 0 - not visited, 1 - in progress,
+	// 2 - done
+	colors := make([]uint8, len(attrs))
+
+	var visit func(i int) error
+
+	visit = func(i int) error {
+		switch colors[i] {
+		case 1:
+			return fmt.Errorf("attribute %q: cyclic parents", attrs[i].GetKey())
+		case 2:
+			return nil
+		default:
+		}
+
+		colors[i] = 1
+
+		for _, p := range attrs[i].GetParents() {
+			if err := visit(index[p]); err != nil {
+				return err
+			}
+		}
+
+		colors[i] = 2
+
+		return nil
+	}
+
+	for i := range attrs {
+		if err := visit(i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AttributeAncestors returns all ancestors of the node attribute with the
+// given key in breadth-first order: parents in the declared order, then their
+// parents and so on. Each ancestor is returned once. Returns nil if the
+// attribute has no parents. Returns an error if the attribute or any of its
+// ancestors is missing or the attribute is its own ancestor. Use
+// ValidateAttributeParents to check the whole hierarchy.
+func AttributeAncestors(node *NodeInfo, key string) ([]Attribute, error) {
+	attrs := node.GetAttributes()
+	index := make(map[string]int, len(attrs))
+
+	for i := range attrs {
+		if _, ok := index[attrs[i].GetKey()]; !ok {
+			index[attrs[i].GetKey()] = i
+		}
+	}
+
+	start, ok := index[key]
+	if !ok {
+		return nil, fmt.Errorf("missing attribute %q", key)
+	}
+
+	var (
+		res   []Attribute
+		queue = []int{start}
+		seen  = map[int]struct{}{start: {}}
+	)
+
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		for _, p := range attrs[cur].GetParents() {
+			j, ok := index[p]
+			if !ok {
+				return nil, fmt.Errorf("attribute %q: missing parent %q", attrs[cur].GetKey(), p)
+			}
+
+			if j == start {
+				return nil, fmt.Errorf("attribute %q: cyclic parents", key)
+			}
+
+			if _, ok := seen[j]; ok {
+				continue
+			}
+
+			seen[j] = struct{}{}
+			queue = append(queue, j)
+			res = append(res, attrs[j])
+		}
+	}
+
+	return res, nil
+}
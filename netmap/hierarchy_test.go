@@ -0,0 +1,62 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func withParents(n *netmap.NodeInfo, key string, parents ...string) {
+	attrs := n.GetAttributes()
+	for i := range attrs {
+		if attrs[i].GetKey() == key {
+			attrs[i].SetParents(parents)
+		}
+	}
+}
+
+func TestAttributeHierarchy(t *testing.T) {
+	n := testNode(1, "Rack", "r1", "Room", "101", "Floor", "1", "Building", "A", "DC", "msk-1")
+	withParents(&n, "Rack", "Room")
+	withParents(&n, "Room", "Floor", "DC")
+	withParents(&n, "Floor", "Building")
+	withParents(&n, "Building", "DC")
+
+	require.NoError(t, netmap.ValidateAttributeParents(&n))
+
+	as, err := netmap.AttributeAncestors(&n, "Rack")
+	require.NoError(t, err)
+
+	var keys []string
+	for i := range as {
+		keys = append(keys, as[i].GetKey())
+	}
+
+	require.Equal(t, []string{"Room", "Floor", "DC", "Building"}, keys)
+
+	as, err = netmap.AttributeAncestors(&n, "DC")
+	require.NoError(t, err)
+	require.Empty(t, as)
+
+	_, err = netmap.AttributeAncestors(&n, "Row")
+	require.Error(t, err)
+
+	// missing parent
+	withParents(&n, "DC", "City")
+	require.ErrorContains(t, netmap.ValidateAttributeParents(&n), `missing parent "City"`)
+
+	_, err = netmap.AttributeAncestors(&n, "Rack")
+	require.Error(t, err)
+
+	// cycle
+	withParents(&n, "DC", "Room")
+	require.ErrorContains(t, netmap.ValidateAttributeParents(&n), "cyclic")
+
+	_, err = netmap.AttributeAncestors(&n, "Room")
+	require.Error(t, err)
+
+	// duplicated key
+	n = testNode(1, "A", "1", "A", "2")
+	require.Error(t, netmap.ValidateAttributeParents(&n))
+}
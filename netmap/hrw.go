@@ -0,0 +1,103 @@
+package netmap
+
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/hrw"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// SortNodesByObject sorts nodes by weighted rendezvous hashing relative to the
+// object (github.com/nspcc-dev/hrw with murmur3 hashes of the node public keys
+// and the object ID): the first node is the closest one. If weight function
+// is not set or all nodes have the same weight, nodes are sorted by distance
+// only. With CapacityPriceWeights of the network map nodes the order is the
+// one NeoFS storage nodes use for the object placement.
+func SortNodesByObject(nodes []NodeInfo, obj *refs.ObjectID, weight NodeWeightFunc) {
+	hashes := make([]uint64, len(nodes))
+
+	var weights []float64
+	if weight != nil {
+		weights = make([]float64, len(nodes))
+	}
+
+	for i := range nodes {
+		hashes[i] = hrw.Hash(nodes[i].GetPublicKey())
+
+		if weight != nil {
+			weights[i] = weight(&nodes[i])
+		}
+	}
+
+	order := hrw.SortByWeight(hashes, weights, hrw.Hash(obj.GetValue()))
+	sorted := make([]NodeInfo, len(nodes))
+
+	for i := range order {
+		sorted[i] = nodes[order[i]]
+	}
+
+	copy(nodes, sorted)
+}
+
+// ObjectNodes returns copy of the container node vectors (see ContainerNodes)
+// with each vector sorted by SortNodesByObject.
+func ObjectNodes(vectors [][]NodeInfo, obj *refs.ObjectID, weight NodeWeightFunc) [][]NodeInfo {
+	res := make([][]NodeInfo, len(vectors))
+
+	for i := range vectors {
+		res[i] = make([]NodeInfo, len(vectors[i]))
+		copy(res[i], vectors[i])
+
+		SortNodesByObject(res[i], obj, weight)
+	}
+
+	return res
+}
+
+// PutNodes returns nodes to store the object to: first replica count nodes of
+// each object node vector (see ObjectNodes). Vectors must correspond to the
+// policy replicas.
+func PutNodes(p *PlacementPolicy, vectors [][]NodeInfo) ([][]NodeInfo, error) {
+	replicas := p.GetReplicas()
+	if len(replicas) != len(vectors) {
+		return nil, fmt.Errorf("%d node vectors for %d replicas", len(vectors), len(replicas))
+	}
+
+	res := make([][]NodeInfo, len(vectors))
+
+	for i := range replicas {
+		count := int(replicas[i].GetCount())
+		if count > len(vectors[i]) {
+			return nil, fmt.Errorf("replica #%d: %w: %d nodes required, %d found",
+				i, ErrNotEnoughNodes, count, len(vectors[i]))
+		}
+
+		res[i] = vectors[i][:count:count]
+	}
+
+	return res, nil
+}
+
+// GetNodes returns nodes to read the object from in order of preference: all
+// nodes of the object node vectors (see ObjectNodes) one vector after another.
+// Nodes present in several vectors are listed once.
+func GetNodes(vectors [][]NodeInfo) []NodeInfo {
+	var (
+		res  []NodeInfo
+		seen = make(map[string]struct{})
+	)
+
+	for i := range vectors {
+		for j := range vectors[i] {
+			key := string(vectors[i][j].GetPublicKey())
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+			res = append(res, vectors[i][j])
+		}
+	}
+
+	return res
+}
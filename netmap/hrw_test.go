@@ -0,0 +1,187 @@
+package netmap_test
+
+import (
+	"crypto/sha256"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func testObjectID(s string) *refs.ObjectID {
+	h := sha256.Sum256([]byte(s))
+
+	var id refs.ObjectID
+	id.SetValue(h[:])
+
+	return &id
+}
+
+func TestSortNodesByObject(t *testing.T) {
+	var nodes []netmap.NodeInfo
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, testNode(byte(i)))
+	}
+
+	obj := testObjectID("object")
+
+	sorted := append([]netmap.NodeInfo(nil), nodes...)
+	netmap.SortNodesByObject(sorted, obj, nil)
+	require.ElementsMatch(t, nodes, sorted)
+
+	// github.com/nspcc-dev/hrw.Sort of the key hashes
+	require.Equal(t, []byte{1, 7, 6, 2, 5, 9, 0, 8, 4, 3}, nodeKeys(sorted))
+
+	for i := 0; i < 10; i++ {
+		shuffled := append([]netmap.NodeInfo(nil), nodes...)
+		rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+
+		netmap.SortNodesByObject(shuffled, obj, nil)
+		require.Equal(t, sorted, shuffled)
+	}
+
+	other := append([]netmap.NodeInfo(nil), nodes...)
+	netmap.SortNodesByObject(other, testObjectID("other"), nil)
+	require.NotEqual(t, sorted, other)
+
+	t.Run("NeoFS SDK", func(t *testing.T) {
+		nodes := neofsTestNodes()
+
+		// NetMap.PlacementVectors of the single vector with all nodes
+		netmap.SortNodesByObject(nodes, obj, netmap.CapacityPriceWeights(nodes))
+		require.Equal(t, []byte{12, 7, 2, 15, 1, 9, 14, 5, 6, 10, 0, 11, 3, 13, 4, 8}, nodeKeys(nodes))
+	})
+}
+
+func TestObjectNodesNeoFS(t *testing.T) {
+	var nm netmap.NetMap
+	nm.SetNodes(neofsTestNodes())
+
+	cnr := sha256.Sum256([]byte("container"))
+	obj := testObjectID("object")
+
+	// reference vectors are NetMap.PlacementVectors results of the NeoFS SDK
+	// (github.com/nspcc-dev/neofs-sdk-go v1.0.0-rc.11) for the container
+	// vectors of TestContainerNodesNeoFS
+	for _, tc := range []struct {
+		name    string
+		policy  *netmap.PlacementPolicy
+		vectors [][]byte
+	}{
+		{
+			name:    "default selector",
+			policy:  testPolicy(1, []netmap.Replica{testReplica(3, "")}, nil),
+			vectors: [][]byte{{12, 2, 5}},
+		},
+		{
+			name: "distinct with filter",
+			policy: testPolicy(2, []netmap.Replica{testReplica(1, "X"), testReplica(2, "Y")},
+				[]netmap.Selector{
+					testSelector("X", 2, netmap.Distinct, "Country", "*"),
+					testSelector("Y", 3, netmap.Distinct, "", "Big"),
+				},
+				testFilter("Big", "Capacity", netmap.GE, "50"),
+			),
+			vectors: [][]byte{{12, 9, 11, 13}, {12, 2, 15, 5, 11, 3}},
+		},
+		{
+			name: "same",
+			policy: testPolicy(1, []netmap.Replica{testReplica(3, "X")},
+				[]netmap.Selector{testSelector("X", 3, netmap.Same, "Country", "*")}),
+			vectors: [][]byte{{9, 10, 11}},
+		},
+		{
+			name: "default backup factor",
+			policy: testPolicy(0, []netmap.Replica{testReplica(2, "X")},
+				[]netmap.Selector{testSelector("X", 2, netmap.Distinct, "Country", "*")}),
+			vectors: [][]byte{{9, 5, 10, 11, 3, 4}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vectors, err := netmap.ContainerNodes(&nm, tc.policy, cnr[:])
+			require.NoError(t, err)
+
+			vectors = netmap.ObjectNodes(vectors, obj, netmap.CapacityPriceWeights(nm.Nodes()))
+			require.Len(t, vectors, len(tc.vectors))
+
+			for i := range vectors {
+				require.Equal(t, tc.vectors[i], nodeKeys(vectors[i]), i)
+			}
+		})
+	}
+}
+
+func TestSortNodesByObjectWeighted(t *testing.T) {
+	nodes := []netmap.NodeInfo{
+		testNode(0, "Capacity", "1", "Price", "1"),
+		testNode(1, "Capacity", "100", "Price", "1"),
+		testNode(2, "Capacity", "1", "Price", "1"),
+		testNode(3, "Capacity", "0", "Price", "1"),
+	}
+
+	w := netmap.CapacityPriceWeights(nodes)
+	first := make(map[byte]int)
+
+	for i := 0; i < 1000; i++ {
+		ns := append([]netmap.NodeInfo(nil), nodes...)
+		netmap.SortNodesByObject(ns, testObjectID(strconv.Itoa(i)), w)
+
+		first[ns[0].GetPublicKey()[0]]++
+
+		// zero weight is always the last
+		require.Equal(t, []byte{3}, ns[3].GetPublicKey())
+	}
+
+	require.Greater(t, first[1], 900)
+	require.Zero(t, first[3])
+}
+
+func TestPutGetNodes(t *testing.T) {
+	nm := testNetMap()
+	p := testPolicy(2, []netmap.Replica{testReplica(1, "X"), testReplica(2, "Y")}, []netmap.Selector{
+		testSelector("X", 1, netmap.Distinct, "Country", ""),
+		testSelector("Y", 2, netmap.Distinct, "", ""),
+	})
+
+	vectors, err := netmap.ContainerNodes(nm, p, []byte("container"))
+	require.NoError(t, err)
+
+	obj := testObjectID("object")
+	objVectors := netmap.ObjectNodes(vectors, obj, netmap.CapacityPriceWeights(nm.Nodes()))
+	require.Len(t, objVectors, 2)
+
+	for i := range vectors {
+		require.ElementsMatch(t, vectors[i], objVectors[i])
+	}
+
+	put, err := netmap.PutNodes(p, objVectors)
+	require.NoError(t, err)
+	require.Len(t, put, 2)
+	require.Equal(t, objVectors[0][:1], put[0])
+	require.Equal(t, objVectors[1][:2], put[1])
+
+	get := netmap.GetNodes(objVectors)
+	require.Equal(t, objVectors[0], get[:len(objVectors[0])])
+
+	seen := make(map[byte]struct{})
+	for i := range get {
+		seen[get[i].GetPublicKey()[0]] = struct{}{}
+	}
+
+	require.Len(t, seen, len(get))
+
+	for i := range objVectors {
+		for j := range objVectors[i] {
+			require.Contains(t, seen, objVectors[i][j].GetPublicKey()[0])
+		}
+	}
+
+	_, err = netmap.PutNodes(p, objVectors[:1])
+	require.Error(t, err)
+
+	_, err = netmap.PutNodes(p, [][]netmap.NodeInfo{objVectors[0], objVectors[1][:1]})
+	require.ErrorIs(t, err, netmap.ErrNotEnoughNodes)
+}
@@ -0,0 +1,218 @@
+package netmap
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Keys to the node attributes describing the node location. AttrUNLOCODE is
+// declared by the node, the others are derived from it using LOCODEDB (see
+// FillLOCODEAttributes).
+const (
+	AttrUNLOCODE    = "UN-LOCODE"
+	AttrCountryCode = "CountryCode"
+	AttrCountry     = "Country"
+	AttrLocation    = "Location"
+	AttrSubDivCode  = "SubDivCode"
+	AttrSubDiv      = "SubDiv"
+	AttrContinent   = "Continent"
+)
+
+// ErrLOCODENotFound is returned by LOCODEDB implementations when there is no
+// record for the requested UN/LOCODE.
+var ErrLOCODENotFound = errors.New("UN/LOCODE not found")
+
+// LOCODERecord describes geographic location of the UN/LOCODE entry. Empty
+// fields are unknown.
+type LOCODERecord struct {
+	// CountryCode is an ISO 3166 alpha-2 country code, e.g. "RU".
+	CountryCode string
+
+	// Country is a country name, e.g. "Russia".
+	Country string
+
+	// Location is a location name, e.g. "Moskva".
+	Location string
+
+	// SubDivCode is an ISO 3166-2 subdivision code without the country code,
+	// e.g. "MOW".
+	SubDivCode string
+
+	// SubDiv is a subdivision name, e.g. "Moskva".
+	SubDiv string
+
+	// Continent is a continent name, e.g. "Europe".
+	Continent string
+}
+
+// LOCODEDB is a database of UN/LOCODE entries.
+type LOCODEDB interface {
+	// Get returns the record of the UN/LOCODE in "CC LLL" format (country
+	// code and location code separated by space). Returns ErrLOCODENotFound
+	// if there is no record for the UN/LOCODE.
+	Get(locode string) (LOCODERecord, error)
+}
+
+// LOCODETable is an in-memory LOCODEDB.
+type LOCODETable map[string]LOCODERecord
+
+// Get implements LOCODEDB.
+func (x LOCODETable) Get(locode string) (LOCODERecord, error) {
+	rec, ok := x[locode]
+	if !ok {
+		return LOCODERecord{}, fmt.Errorf("%w: %s", ErrLOCODENotFound, locode)
+	}
+
+	return rec, nil
+}
+
+// ReadLOCODETable reads LOCODETable from CSV with the following columns:
+// UN/LOCODE, CountryCode, Country, Location, SubDivCode, SubDiv and
+// Continent (see LOCODERecord). Returns an error if any UN/LOCODE is invalid
+// (see ValidateLOCODE) or duplicated.
+func ReadLOCODETable(r io.Reader) (LOCODETable, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 7
+	cr.ReuseRecord = true
+
+	res := make(LOCODETable)
+
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := cr.FieldPos(0)
+
+		if err = ValidateLOCODE(rec[0]); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		if _, ok := res[rec[0]]; ok {
+			return nil, fmt.Errorf("line %d: duplicated UN/LOCODE %s", line, rec[0])
+		}
+
+		res[rec[0]] = LOCODERecord{
+			CountryCode: rec[1],
+			Country:     rec[2],
+			Location:    rec[3],
+			SubDivCode:  rec[4],
+			SubDiv:      rec[5],
+			Continent:   rec[6],
+		}
+	}
+}
+
+// ValidateLOCODE checks if the string is UN/LOCODE in "CC LLL" format: two
+// latin capital letters of the country code and three latin capital letters
+// or digits 2-9 of the location code separated by space.
+func ValidateLOCODE(s string) error {
+	if len(s) != 6 || s[2] != ' ' {
+		return fmt.Errorf("invalid UN/LOCODE %q: \"CC LLL\" format expected", s)
+	}
+
+	for i := 0; i < 2; i++ {
+		if s[i] < 'A' || s[i] > 'Z' {
+			return fmt.Errorf("invalid UN/LOCODE %q: invalid country code", s)
+		}
+	}
+
+	for i := 3; i < 6; i++ {
+		if (s[i] < 'A' || s[i] > 'Z') && (s[i] < '2' || s[i] > '9') {
+			return fmt.Errorf("invalid UN/LOCODE %q: invalid location code", s)
+		}
+	}
+
+	return nil
+}
+
+// FillLOCODEAttributes sets the node attributes derived from the AttrUNLOCODE
+// one using the database: AttrCountryCode, AttrCountry, AttrLocation,
+// AttrSubDivCode, AttrSubDiv and AttrContinent. Existing attributes with
+// these keys are replaced, attributes for the empty record fields are not
+// set. Does nothing if the node has no AttrUNLOCODE attribute.
+//
+// Returns an error if AttrUNLOCODE is invalid (see ValidateLOCODE) or the
+// database fails, including ErrLOCODENotFound.
+func FillLOCODEAttributes(node *NodeInfo, db LOCODEDB) error {
+	var locode string
+
+	attrs := node.GetAttributes()
+
+	for i := range attrs {
+		if attrs[i].GetKey() == AttrUNLOCODE {
+			locode = attrs[i].GetValue()
+			break
+		}
+	}
+
+	if locode == "" {
+		return nil
+	}
+
+	if err := ValidateLOCODE(locode); err != nil {
+		return err
+	}
+
+	rec, err := db.Get(locode)
+	if err != nil {
+		return fmt.Errorf("get UN/LOCODE record: %w", err)
+	}
+
+	for _, kv := range [...][2]string{
+		{AttrCountryCode, rec.CountryCode},
+		{AttrCountry, rec.Country},
+		{AttrLocation, rec.Location},
+		{AttrSubDivCode, rec.SubDivCode},
+		{AttrSubDiv, rec.SubDiv},
+		{AttrContinent, rec.Continent},
+	} {
+		if kv[1] != "" {
+			attrs = setAttribute(attrs, kv[0], kv[1])
+		}
+	}
+
+	node.SetAttributes(attrs)
+
+	return nil
+}
+
+// setAttribute sets value of the first attribute with the key removing the
+// others or appends a new attribute.
+func setAttribute(attrs []Attribute, key, val string) []Attribute {
+	found := false
+
+	for i := 0; i < len(attrs); i++ {
+		if attrs[i].GetKey() != key {
+			continue
+		}
+
+		if found {
+			attrs = append(attrs[:i], attrs[i+1:]...)
+			i--
+
+			continue
+		}
+
+		found = true
+
+		attrs[i].SetValue(val)
+	}
+
+	if !found {
+		var a Attribute
+		a.SetKey(key)
+		a.SetValue(val)
+
+		attrs = append(attrs, a)
+	}
+
+	return attrs
+}
@@ -0,0 +1,109 @@
+package netmap_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+const testLOCODEs = `RU MOW,RU,Russia,Moskva,MOW,Moskva,Europe
+DE FRA,DE,Germany,Frankfurt am Main,HE,Hessen,Europe
+SG SIN,SG,Singapore,Singapore,,,Asia
+`
+
+func TestReadLOCODETable(t *testing.T) {
+	db, err := netmap.ReadLOCODETable(strings.NewReader(testLOCODEs))
+	require.NoError(t, err)
+	require.Len(t, db, 3)
+
+	rec, err := db.Get("DE FRA")
+	require.NoError(t, err)
+	require.Equal(t, netmap.LOCODERecord{
+		CountryCode: "DE",
+		Country:     "Germany",
+		Location:    "Frankfurt am Main",
+		SubDivCode:  "HE",
+		SubDiv:      "Hessen",
+		Continent:   "Europe",
+	}, rec)
+
+	_, err = db.Get("US NYC")
+	require.ErrorIs(t, err, netmap.ErrLOCODENotFound)
+
+	for _, s := range []string{
+		"RU MOW,RU,Russia",
+		"RUMOW,RU,Russia,Moskva,MOW,Moskva,Europe",
+		testLOCODEs + "RU MOW,RU,Russia,Moskva,MOW,Moskva,Europe",
+	} {
+		_, err = netmap.ReadLOCODETable(strings.NewReader(s))
+		require.Error(t, err)
+	}
+}
+
+func TestValidateLOCODE(t *testing.T) {
+	for _, s := range []string{"RU MOW", "US NY2", "GB 9AB"} {
+		require.NoError(t, netmap.ValidateLOCODE(s), s)
+	}
+
+	for _, s := range []string{"", "RUMOW", "ru mow", "RU MO", "RU MOW ", "R1 MOW", "RU MO1", "RU_MOW"} {
+		require.Error(t, netmap.ValidateLOCODE(s), s)
+	}
+}
+
+type failingLOCODEDB struct{}
+
+func (failingLOCODEDB) Get(string) (netmap.LOCODERecord, error) {
+	return netmap.LOCODERecord{}, errors.New("unavailable")
+}
+
+func TestFillLOCODEAttributes(t *testing.T) {
+	db, err := netmap.ReadLOCODETable(strings.NewReader(testLOCODEs))
+	require.NoError(t, err)
+
+	n := testNode(1, "Capacity", "10", netmap.AttrUNLOCODE, "SG SIN", netmap.AttrContinent, "Europe", netmap.AttrContinent, "Africa", netmap.AttrSubDiv, "Unknown")
+	require.NoError(t, netmap.FillLOCODEAttributes(&n, db))
+
+	require.Equal(t, testNode(1,
+		"Capacity", "10",
+		netmap.AttrUNLOCODE, "SG SIN",
+		netmap.AttrContinent, "Asia",
+		netmap.AttrSubDiv, "Unknown",
+		netmap.AttrCountryCode, "SG",
+		netmap.AttrCountry, "Singapore",
+		netmap.AttrLocation, "Singapore",
+	), n)
+
+	// no UN/LOCODE
+	n = testNode(1, "Capacity", "10")
+	require.NoError(t, netmap.FillLOCODEAttributes(&n, failingLOCODEDB{}))
+	require.Equal(t, testNode(1, "Capacity", "10"), n)
+
+	n = testNode(1, netmap.AttrUNLOCODE, "RU MOW")
+	require.Error(t, netmap.FillLOCODEAttributes(&n, failingLOCODEDB{}))
+
+	n = testNode(1, netmap.AttrUNLOCODE, "US NYC")
+	require.ErrorIs(t, netmap.FillLOCODEAttributes(&n, db), netmap.ErrLOCODENotFound)
+
+	n = testNode(1, netmap.AttrUNLOCODE, "moscow")
+	require.Error(t, netmap.FillLOCODEAttributes(&n, db))
+
+	// derived attributes are used by placement filters
+	var nodes []netmap.NodeInfo
+	for i, locode := range []string{"RU MOW", "DE FRA", "SG SIN"} {
+		nodes = append(nodes, testNode(byte(i), netmap.AttrUNLOCODE, locode))
+		require.NoError(t, netmap.FillLOCODEAttributes(&nodes[i], db))
+	}
+
+	var nm netmap.NetMap
+	nm.SetNodes(nodes)
+
+	p, err := netmap.ParsePlacementPolicy(`REP 2 SELECT 2 IN DISTINCT Country FROM EU FILTER Continent EQ Europe AS EU`)
+	require.NoError(t, err)
+
+	res, err := netmap.ContainerNodes(&nm, p, nil)
+	require.NoError(t, err)
+	require.ElementsMatch(t, nodes[:2], res[0])
+}
@@ -0,0 +1,297 @@
+package netmap
+
+import (
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// Keys to the well-known node attributes having typed accessors. See also
+// location attributes derived from AttrUNLOCODE.
+const (
+	// AttrCapacity is a key to the node storage capacity in GB, base-10
+	// uint64.
+	AttrCapacity = "Capacity"
+
+	// AttrPrice is a key to the storage price of the node, base-10 uint64.
+	AttrPrice = "Price"
+
+	// AttrExternalAddr is a key to the comma-separated list of the node
+	// network addresses available from outside of the NeoFS network.
+	AttrExternalAddr = "ExternalAddr"
+)
+
+// GetCapacity returns value of the AttrCapacity attribute. Returns zero if
+// the attribute is not set. Returns an error if the value is not a base-10
+// uint64 or the attribute is set more than once.
+func (ni *NodeInfo) GetCapacity() (uint64, error) {
+	return ni.getUintAttribute(AttrCapacity)
+}
+
+// SetCapacity sets value of the AttrCapacity attribute replacing all existing
+// ones.
+func (ni *NodeInfo) SetCapacity(v uint64) {
+	ni.SetAttributeValue(AttrCapacity, strconv.FormatUint(v, 10))
+}
+
+// GetPrice returns value of the AttrPrice attribute. Returns zero if the
+// attribute is not set. Returns an error if the value is not a base-10 uint64
+// or the attribute is set more than once.
+func (ni *NodeInfo) GetPrice() (uint64, error) {
+	return ni.getUintAttribute(AttrPrice)
+}
+
+// SetPrice sets value of the AttrPrice attribute replacing all existing ones.
+func (ni *NodeInfo) SetPrice(v uint64) {
+	ni.SetAttributeValue(AttrPrice, strconv.FormatUint(v, 10))
+}
+
+// GetUNLOCODE returns value of the AttrUNLOCODE attribute. Returns empty
+// string if the attribute is not set. Returns an error if the value is not a
+// valid UN/LOCODE (see ValidateLOCODE) or the attribute is set more than once.
+func (ni *NodeInfo) GetUNLOCODE() (string, error) {
+	v, err := ni.getAttribute(AttrUNLOCODE)
+	if err != nil || v == "" {
+		return "", err
+	}
+
+	return v, ValidateLOCODE(v)
+}
+
+// SetUNLOCODE sets value of the AttrUNLOCODE attribute replacing all existing
+// ones.
+func (ni *NodeInfo) SetUNLOCODE(v string) {
+	ni.SetAttributeValue(AttrUNLOCODE, v)
+}
+
+// GetExternalAddresses returns addresses listed in the AttrExternalAddr
+// attribute. Returns nil if the attribute is not set. Returns an error if any
+// address is invalid (see ValidateNodeAddress) or the attribute is set more
+// than once.
+func (ni *NodeInfo) GetExternalAddresses() ([]string, error) {
+	v, err := ni.getAttribute(AttrExternalAddr)
+	if err != nil || v == "" {
+		return nil, err
+	}
+
+	res := strings.Split(v, ",")
+
+	for i := range res {
+		if err = ValidateNodeAddress(res[i]); err != nil {
+			return nil, fmt.Errorf("invalid attribute %s: %w", AttrExternalAddr, err)
+		}
+	}
+
+	return res, nil
+}
+
+// SetExternalAddresses sets value of the AttrExternalAddr attribute replacing
+// all existing ones.
+func (ni *NodeInfo) SetExternalAddresses(addrs ...string) {
+	ni.SetAttributeValue(AttrExternalAddr, strings.Join(addrs, ","))
+}
+
+// AttributeValue returns value of the first attribute with the key. Returns
+// empty string if the attribute is not set.
+func (ni *NodeInfo) AttributeValue(key string) string {
+	attrs := ni.GetAttributes()
+
+	for i := range attrs {
+		if attrs[i].GetKey() == key {
+			return attrs[i].GetValue()
+		}
+	}
+
+	return ""
+}
+
+// SetAttributeValue sets value of the attribute with the key replacing all
+// existing ones. Parents of the existing attribute are kept.
+func (ni *NodeInfo) SetAttributeValue(key, val string) {
+	ni.SetAttributes(setAttribute(ni.GetAttributes(), key, val))
+}
+
+func (ni *NodeInfo) getAttribute(key string) (string, error) {
+	var (
+		res   string
+		found bool
+	)
+
+	attrs := ni.GetAttributes()
+
+	for i := range attrs {
+		if attrs[i].GetKey() != key {
+			continue
+		}
+
+		if found {
+			return "", fmt.Errorf("duplicated attribute %s", key)
+		}
+
+		res, found = attrs[i].GetValue(), true
+	}
+
+	return res, nil
+}
+
+func (ni *NodeInfo) getUintAttribute(key string) (uint64, error) {
+	v, err := ni.getAttribute(key)
+	if err != nil || v == "" {
+		return 0, err
+	}
+
+	res, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid attribute %s: %w", key, err)
+	}
+
+	return res, nil
+}
+
+// ValidateNodeInfo checks if the storage node record can be accepted by the
+// network:
+//   - public key is a compressed secp256r1 (P-256) public key;
+//   - there is at least one network address, all addresses are valid (see
+//     ValidateNodeAddress);
+//   - attribute keys and values are not empty, keys are unique and attribute
+//     hierarchy is consistent (see ValidateAttributeParents);
+//   - values of the well-known attributes with typed accessors are valid;
+//   - subnet attributes are valid and the node belongs to at least one subnet
+//     (see IterateSubnets);
+//   - state is Online, Offline or Maintenance.
+//
+// All found problems are joined into the returned error.
+func ValidateNodeInfo(ni *NodeInfo) error {
+	var errs []error
+
+	if key := ni.GetPublicKey(); len(key) != 33 {
+		errs = append(errs, fmt.Errorf("invalid public key length %d, 33 expected", len(key)))
+	} else if x, _ := elliptic.UnmarshalCompressed(elliptic.P256(), key); x == nil {
+		errs = append(errs, errors.New("invalid compressed secp256r1 public key"))
+	}
+
+	if ni.NumberOfAddresses() == 0 {
+		errs = append(errs, errors.New("no network addresses"))
+	}
+
+	ni.IterateAddresses(func(addr string) bool {
+		if err := ValidateNodeAddress(addr); err != nil {
+			errs = append(errs, err)
+		}
+
+		return false
+	})
+
+	attrs := ni.GetAttributes()
+
+	for i := range attrs {
+		switch {
+		case attrs[i].GetKey() == "":
+			errs = append(errs, fmt.Errorf("attribute #%d: empty key", i))
+		case attrs[i].GetValue() == "":
+			errs = append(errs, fmt.Errorf("attribute %s: empty value", attrs[i].GetKey()))
+		}
+	}
+
+	if err := ValidateAttributeParents(ni); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := ni.GetCapacity(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := ni.GetPrice(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := ni.GetUNLOCODE(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if _, err := ni.GetExternalAddresses(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := IterateSubnets(ni, func(refs.SubnetID) error { return nil }); err != nil {
+		errs = append(errs, fmt.Errorf("subnets: %w", err))
+	}
+
+	switch st := ni.GetState(); st {
+	default:
+		errs = append(errs, fmt.Errorf("unsupported state %s", st))
+	case Online, Offline, Maintenance:
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateNodeAddress checks if the node network address is either a
+// multiaddr of the host (/ip4, /ip6, /dns, /dns4 or /dns6) and the TCP port
+// with optional /tls suffix, e.g. "/dns4/node.neofs/tcp/8080/tls", or
+// "host:port" pair, e.g. "node.neofs:8080".
+func ValidateNodeAddress(addr string) error {
+	if !strings.HasPrefix(addr, "/") {
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+
+		if host == "" {
+			return fmt.Errorf("invalid address %q: empty host", addr)
+		}
+
+		if err = validatePort(port); err != nil {
+			return fmt.Errorf("invalid address %q: %w", addr, err)
+		}
+
+		return nil
+	}
+
+	parts := strings.Split(addr[1:], "/")
+
+	if len(parts) != 4 && (len(parts) != 5 || parts[4] != "tls") {
+		return fmt.Errorf("invalid multiaddr %q: /<host protocol>/<host>/tcp/<port>[/tls] expected", addr)
+	}
+
+	switch proto, host := parts[0], parts[1]; proto {
+	default:
+		return fmt.Errorf("invalid multiaddr %q: unsupported host protocol %s", addr, proto)
+	case "ip4":
+		if ip := net.ParseIP(host); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("invalid multiaddr %q: invalid IPv4 address", addr)
+		}
+	case "ip6":
+		if ip := net.ParseIP(host); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("invalid multiaddr %q: invalid IPv6 address", addr)
+		}
+	case "dns", "dns4", "dns6":
+		if host == "" {
+			return fmt.Errorf("invalid multiaddr %q: empty host", addr)
+		}
+	}
+
+	if parts[2] != "tcp" {
+		return fmt.Errorf("invalid multiaddr %q: unsupported transport protocol %s", addr, parts[2])
+	}
+
+	if err := validatePort(parts[3]); err != nil {
+		return fmt.Errorf("invalid multiaddr %q: %w", addr, err)
+	}
+
+	return nil
+}
+
+func validatePort(s string) error {
+	port, err := strconv.ParseUint(s, 10, 16)
+	if err != nil || port == 0 {
+		return fmt.Errorf("invalid port %q", s)
+	}
+
+	return nil
+}
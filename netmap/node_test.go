@@ -0,0 +1,173 @@
+package netmap_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeInfo_WellKnownAttributes(t *testing.T) {
+	var n netmap.NodeInfo
+
+	v, err := n.GetCapacity()
+	require.NoError(t, err)
+	require.Zero(t, v)
+
+	addrs, err := n.GetExternalAddresses()
+	require.NoError(t, err)
+	require.Nil(t, addrs)
+
+	n.SetAttributeValue("Other", "value")
+	n.SetCapacity(100)
+	n.SetPrice(5)
+	n.SetUNLOCODE("RU MOW")
+	n.SetExternalAddresses("/dns4/node/tcp/8080", "node:8081")
+
+	v, err = n.GetCapacity()
+	require.NoError(t, err)
+	require.EqualValues(t, 100, v)
+
+	v, err = n.GetPrice()
+	require.NoError(t, err)
+	require.EqualValues(t, 5, v)
+
+	locode, err := n.GetUNLOCODE()
+	require.NoError(t, err)
+	require.Equal(t, "RU MOW", locode)
+
+	addrs, err = n.GetExternalAddresses()
+	require.NoError(t, err)
+	require.Equal(t, []string{"/dns4/node/tcp/8080", "node:8081"}, addrs)
+
+	require.Equal(t, "value", n.AttributeValue("Other"))
+	require.Equal(t, "100", n.AttributeValue(netmap.AttrCapacity))
+	require.Empty(t, n.AttributeValue("Missing"))
+
+	n.SetCapacity(200)
+	require.Len(t, n.GetAttributes(), 5)
+	require.Equal(t, "200", n.AttributeValue(netmap.AttrCapacity))
+
+	n = testNode(1, netmap.AttrCapacity, "1", netmap.AttrCapacity, "2", netmap.AttrPrice, "cheap",
+		netmap.AttrUNLOCODE, "Moscow", netmap.AttrExternalAddr, "node")
+
+	_, err = n.GetCapacity()
+	require.Error(t, err)
+
+	_, err = n.GetPrice()
+	require.Error(t, err)
+
+	_, err = n.GetUNLOCODE()
+	require.Error(t, err)
+
+	_, err = n.GetExternalAddresses()
+	require.Error(t, err)
+
+	n.SetCapacity(3)
+
+	v, err = n.GetCapacity()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, v)
+}
+
+func TestValidateNodeAddress(t *testing.T) {
+	for _, addr := range []string{
+		"/dns4/s01.neofs.devenv/tcp/8080",
+		"/dns/node/tcp/8080/tls",
+		"/ip4/192.168.0.1/tcp/65535",
+		"/ip6/::1/tcp/8080",
+		"node:8080",
+		"192.168.0.1:8080",
+		"[::1]:8080",
+	} {
+		require.NoError(t, netmap.ValidateNodeAddress(addr), addr)
+	}
+
+	for _, addr := range []string{
+		"",
+		"node",
+		":8080",
+		"node:http",
+		"node:0",
+		"node:65536",
+		"/",
+		"/dns4/node",
+		"/dns4//tcp/8080",
+		"/ip4/::1/tcp/8080",
+		"/ip6/1.2.3.4/tcp/8080",
+		"/ip4/node/tcp/8080",
+		"/unix/node/tcp/8080",
+		"/dns4/node/udp/8080",
+		"/dns4/node/tcp/port",
+		"/dns4/node/tcp/8080/http",
+		"/dns4/node/tcp/8080/tls/",
+	} {
+		require.Error(t, netmap.ValidateNodeAddress(addr), addr)
+	}
+}
+
+func TestValidateNodeInfo(t *testing.T) {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	valid := func() netmap.NodeInfo {
+		n := testNode(0, netmap.AttrCapacity, "100", netmap.AttrPrice, "1", netmap.AttrUNLOCODE, "RU MOW")
+		n.SetPublicKey(elliptic.MarshalCompressed(k.Curve, k.X, k.Y))
+		n.SetAddresses("/dns4/node/tcp/8080", "node:8081")
+
+		return n
+	}
+
+	n := valid()
+	require.NoError(t, netmap.ValidateNodeInfo(&n))
+
+	for _, tc := range []struct {
+		name   string
+		modify func(*netmap.NodeInfo)
+	}{
+		{"short key", func(n *netmap.NodeInfo) { n.SetPublicKey(n.GetPublicKey()[1:]) }},
+		{"uncompressed key", func(n *netmap.NodeInfo) { n.SetPublicKey(elliptic.Marshal(k.Curve, k.X, k.Y)) }},
+		{"invalid key", func(n *netmap.NodeInfo) { n.SetPublicKey(append([]byte{0x04}, n.GetPublicKey()[1:]...)) }},
+		{"no addresses", func(n *netmap.NodeInfo) { n.SetAddresses() }},
+		{"invalid address", func(n *netmap.NodeInfo) { n.SetAddresses("node") }},
+		{"duplicated attribute", func(n *netmap.NodeInfo) {
+			var a netmap.Attribute
+			a.SetKey(netmap.AttrPrice)
+			a.SetValue("2")
+			n.SetAttributes(append(n.GetAttributes(), a))
+		}},
+		{"empty attribute", func(n *netmap.NodeInfo) { n.SetAttributeValue("Other", "") }},
+		{"missing parent", func(n *netmap.NodeInfo) { withParents(n, netmap.AttrPrice, "Missing") }},
+		{"non-numeric capacity", func(n *netmap.NodeInfo) { n.SetAttributeValue(netmap.AttrCapacity, "big") }},
+		{"negative price", func(n *netmap.NodeInfo) { n.SetAttributeValue(netmap.AttrPrice, "-1") }},
+		{"invalid UN/LOCODE", func(n *netmap.NodeInfo) { n.SetUNLOCODE("Moscow") }},
+		{"invalid external address", func(n *netmap.NodeInfo) { n.SetExternalAddresses("node:8080", "node") }},
+		{"invalid subnet attribute", func(n *netmap.NodeInfo) { n.SetAttributeValue("__NEOFS__SUBNET_1", "Yes") }},
+		{"no subnets", func(n *netmap.NodeInfo) {
+			var info netmap.NodeSubnetInfo
+			info.SetID(new(refs.SubnetID))
+			info.SetEntryFlag(false)
+			netmap.WriteSubnetInfo(n, info)
+		}},
+		{"unspecified state", func(n *netmap.NodeInfo) { n.SetState(netmap.UnspecifiedState) }},
+		{"unknown state", func(n *netmap.NodeInfo) { n.SetState(10) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			n := valid()
+			tc.modify(&n)
+			require.Error(t, netmap.ValidateNodeInfo(&n))
+		})
+	}
+
+	// all problems are reported
+	var empty netmap.NodeInfo
+
+	err = netmap.ValidateNodeInfo(&empty)
+	require.ErrorContains(t, err, "public key")
+	require.ErrorContains(t, err, "no network addresses")
+	require.ErrorContains(t, err, "state")
+}
@@ -0,0 +1,535 @@
+package netmap
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/nspcc-dev/hrw"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// MainFilterName is a name of the filter matching all nodes. It can be used
+// as Selector filter and referenced from the compound filters.
+const MainFilterName = "*"
+
+// DefaultContainerBackupFactor is a container backup factor used when the
+// placement policy does not specify it.
+const DefaultContainerBackupFactor = 3
+
+// ErrNotEnoughNodes is returned by ContainerNodes when the network map does
+// not have enough nodes satisfying the placement policy.
+var ErrNotEnoughNodes = errors.New("not enough nodes")
+
+// placementNode is a node of the network map prepared for the placement.
+type placementNode struct {
+	info   *NodeInfo
+	hash   uint64
+	weight float64
+	attrs  map[string]string
+}
+
+// placementBucket is a group of nodes with the same attribute value.
+type placementBucket struct {
+	attr  string
+	nodes []*placementNode
+}
+
+type placementContext struct {
+	nodes     []*placementNode
+	filters   map[string]*Filter
+	pivot     []byte
+	pivotHash uint64
+	weight    NodeWeightFunc
+	cbf       uint32
+}
+
+// ContainerNodes returns nodes of the network map storing the container with
+// the given placement policy, one node vector per policy replica. Vector
+// consists of the nodes selected by the replica's selector (all selectors if
+// not specified) including backup ones, so its first nodes are the main
+// container nodes. The result is deterministic for the given pivot which is
+// normally the container ID. With the container ID as pivot, the vectors are
+// the ones NeoFS storage nodes compute (see NetMap.ContainerNodes of the
+// NeoFS SDK).
+//
+// Only nodes that belong to the policy subnet are considered, nodes in Offline
+// state are skipped.
+//
+// Selector takes nodes matched by its filter (MainFilterName or empty name for
+// all nodes), groups them in buckets by the attribute value (if set, otherwise
+// each node is a separate bucket, nodes without the attribute fall into the
+// bucket of the empty value) and selects:
+//   - Same clause: one bucket of count*backupFactor nodes;
+//   - Distinct or unspecified clause: count buckets of backupFactor nodes.
+//
+// If there are not enough buckets of the desired size, smaller buckets are
+// taken (at least one node per Distinct bucket or count nodes for the Same
+// one). Nodes in the buckets and buckets themselves are ordered using
+// weighted rendezvous hashing (github.com/nspcc-dev/hrw) relative to the
+// pivot. Node weights are given by CapacityPriceWeights of all network map
+// nodes, bucket weight is the mean of its node weights. Without pivot, nodes
+// keep the network map order and buckets are sorted by the attribute value
+// or by the node public key hash.
+//
+// Filters with EQ and NE operations compare attribute values as strings
+// (missing attribute is an empty value), with GT, GE, LT and LE - as base-10
+// uint64 (nodes with non-numeric values do not match, missing or non-numeric
+// Capacity and Price are zero like in CapacityPriceWeights). AND and OR filters
+// combine sub-filters, which can also reference named filters of the policy
+// by name (filter with no operation set).
+//
+// Returns ErrNotEnoughNodes if any selector can not be satisfied, other
+// errors mean invalid placement policy.
+func ContainerNodes(nm *NetMap, p *PlacementPolicy, pivot []byte) ([][]NodeInfo, error) {
+	c, err := newPlacementContext(nm, p, pivot)
+	if err != nil {
+		return nil, err
+	}
+
+	selectors := p.GetSelectors()
+	selections := make(map[string][]*placementNode, len(selectors))
+
+	for i := range selectors {
+		name := selectors[i].GetName()
+
+		if _, ok := selections[name]; ok {
+			return nil, fmt.Errorf("duplicated selector %q", name)
+		}
+
+		var err error
+
+		selections[name], err = c.selectNodes(&selectors[i])
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", name, err)
+		}
+	}
+
+	replicas := p.GetReplicas()
+	if len(replicas) == 0 {
+		return nil, errors.New("no replicas")
+	}
+
+	res := make([][]NodeInfo, len(replicas))
+
+	for i := range replicas {
+		var nodes []*placementNode
+
+		switch name := replicas[i].GetSelector(); {
+		case name != "":
+			var ok bool
+
+			nodes, ok = selections[name]
+			if !ok {
+				return nil, fmt.Errorf("replica #%d: unknown selector %q", i, name)
+			}
+		case len(selectors) != 0:
+			for j := range selectors {
+				nodes = append(nodes, selections[selectors[j].GetName()]...)
+			}
+		default:
+			var s Selector
+			s.SetCount(replicas[i].GetCount())
+			s.SetFilter(MainFilterName)
+
+			var err error
+
+			nodes, err = c.selectNodes(&s)
+			if err != nil {
+				return nil, fmt.Errorf("replica #%d: %w", i, err)
+			}
+		}
+
+		res[i] = make([]NodeInfo, len(nodes))
+		for j := range nodes {
+			res[i][j] = *nodes[j].info
+		}
+	}
+
+	return res, nil
+}
+
+// newPlacementContext prepares the network map nodes and checks the policy
+// filters.
+func newPlacementContext(nm *NetMap, p *PlacementPolicy, pivot []byte) (*placementContext, error) {
+	c := &placementContext{
+		filters: make(map[string]*Filter),
+		pivot:   pivot,
+		weight:  CapacityPriceWeights(nm.Nodes()),
+		cbf:     p.GetContainerBackupFactor(),
+	}
+
+	if len(pivot) != 0 {
+		c.pivotHash = hrw.Hash(pivot)
+	}
+
+	if c.cbf == 0 {
+		c.cbf = DefaultContainerBackupFactor
+	}
+
+	c.collectNodes(nm, p.GetSubnetID())
+
+	filters := p.GetFilters()
+
+	for i := range filters {
+		err := c.addFilter(&filters[i], true)
+		if err != nil {
+			return nil, fmt.Errorf("filter #%d: %w", i, err)
+		}
+	}
+
+	return c, nil
+}
+
+// collectNodes collects nodes of the network map suitable for the placement.
+// Nodes with invalid subnet attributes are skipped.
+func (c *placementContext) collectNodes(nm *NetMap, subnet *refs.SubnetID) {
+	var zeroSubnet refs.SubnetID
+	if subnet == nil {
+		subnet = &zeroSubnet
+	}
+
+	errFound := errors.New("found")
+	nodes := nm.Nodes()
+
+	for i := range nodes {
+		if nodes[i].GetState() == Offline {
+			continue
+		}
+
+		err := IterateSubnets(&nodes[i], func(id refs.SubnetID) error {
+			if id.GetValue() == subnet.GetValue() {
+				return errFound
+			}
+
+			return nil
+		})
+		if !errors.Is(err, errFound) {
+			continue
+		}
+
+		attrs := nodes[i].GetAttributes()
+
+		n := &placementNode{
+			info:   &nodes[i],
+			hash:   hrw.Hash(nodes[i].GetPublicKey()),
+			weight: c.weight(&nodes[i]),
+			attrs:  make(map[string]string, len(attrs)),
+		}
+
+		for j := range attrs {
+			n.attrs[attrs[j].GetKey()] = attrs[j].GetValue()
+		}
+
+		c.nodes = append(c.nodes, n)
+	}
+}
+
+// addFilter checks the filter and remembers named filters. Top-level filters
+// must be named.
+func (c *placementContext) addFilter(f *Filter, top bool) error {
+	name := f.GetName()
+
+	if f.GetOp() == UnspecifiedOperation && !top {
+		// reference to the named filter
+		if name != MainFilterName {
+			if _, ok := c.filters[name]; !ok {
+				return fmt.Errorf("unknown filter %q", name)
+			}
+		}
+
+		return nil
+	}
+
+	switch {
+	case top && name == "":
+		return errors.New("unnamed top-level filter")
+	case name == MainFilterName:
+		return fmt.Errorf("reserved filter name %q", name)
+	}
+
+	if name != "" {
+		if _, ok := c.filters[name]; ok {
+			return fmt.Errorf("duplicated filter %q", name)
+		}
+	}
+
+	switch op := f.GetOp(); op {
+	default:
+		return fmt.Errorf("unsupported operation %s", op)
+	case EQ, NE:
+	case GT, GE, LT, LE:
+		if _, err := strconv.ParseUint(f.GetValue(), 10, 64); err != nil {
+			return fmt.Errorf("invalid numeric value: %w", err)
+		}
+	case AND, OR:
+		sub := f.GetFilters()
+		if len(sub) == 0 {
+			return fmt.Errorf("no sub-filters in %s filter", op)
+		}
+
+		for i := range sub {
+			err := c.addFilter(&sub[i], false)
+			if err != nil {
+				return fmt.Errorf("sub-filter #%d: %w", i, err)
+			}
+		}
+	}
+
+	if name != "" {
+		c.filters[name] = f
+	}
+
+	return nil
+}
+
+func (c *placementContext) match(f *Filter, n *placementNode) bool {
+	switch op := f.GetOp(); op {
+	default:
+		return false
+	case UnspecifiedOperation:
+		name := f.GetName()
+		if name == MainFilterName {
+			return true
+		}
+
+		ref, ok := c.filters[name]
+
+		return ok && c.match(ref, n)
+	case EQ:
+		return n.attrs[f.GetKey()] == f.GetValue()
+	case NE:
+		return n.attrs[f.GetKey()] != f.GetValue()
+	case GT, GE, LT, LE:
+		key := f.GetKey()
+
+		v, err := strconv.ParseUint(n.attrs[key], 10, 64)
+		if err != nil {
+			if key != AttrCapacity && key != AttrPrice {
+				return false
+			}
+
+			v = 0
+		}
+
+		fv, _ := strconv.ParseUint(f.GetValue(), 10, 64)
+
+		switch op {
+		case GT:
+			return v > fv
+		case GE:
+			return v >= fv
+		case LT:
+			return v < fv
+		default:
+			return v <= fv
+		}
+	case AND, OR:
+		sub := f.GetFilters()
+
+		for i := range sub {
+			if c.match(&sub[i], n) != (op == AND) {
+				return op == OR
+			}
+		}
+
+		return op == AND
+	}
+}
+
+func (c *placementContext) selectNodes(s *Selector) ([]*placementNode, error) {
+	count := s.GetCount()
+	if count == 0 {
+		return nil, errors.New("zero node count")
+	}
+
+	bucketCount, nodesInBucket := int(count), 1
+	if s.GetClause() == Same {
+		bucketCount, nodesInBucket = 1, int(count)
+	}
+
+	maxNodesInBucket := nodesInBucket * int(c.cbf)
+
+	buckets, err := c.buckets(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(buckets) < bucketCount {
+		return nil, fmt.Errorf("%w: %d buckets required, %d found", ErrNotEnoughNodes, bucketCount, len(buckets))
+	}
+
+	var res, fallback [][]*placementNode
+
+	for i := range buckets {
+		switch ns := buckets[i].nodes; {
+		case len(ns) >= maxNodesInBucket:
+			res = append(res, ns[:maxNodesInBucket])
+		case len(ns) >= nodesInBucket:
+			fallback = append(fallback, ns)
+		}
+	}
+
+	if len(res) < bucketCount {
+		// fallback to smaller buckets
+		res = append(res, fallback...)
+		if len(res) < bucketCount {
+			return nil, fmt.Errorf("%w: %d buckets of %d nodes required, %d found",
+				ErrNotEnoughNodes, bucketCount, nodesInBucket, len(res))
+		}
+	}
+
+	if len(c.pivot) != 0 {
+		hashes := make([]uint64, len(res))
+		weights := make([]float64, len(res))
+
+		for i := range res {
+			hashes[i] = res[i][0].hash
+
+			ws := make([]float64, len(res[i]))
+			for j := range res[i] {
+				ws[j] = res[i][j].weight
+			}
+
+			weights[i] = meanIQR(ws)
+		}
+
+		order := hrw.SortByWeight(hashes, weights, c.pivotHash)
+		sorted := make([][]*placementNode, len(res))
+
+		for i := range order {
+			sorted[i] = res[order[i]]
+		}
+
+		res = sorted
+	}
+
+	if s.GetAttribute() == "" {
+		// single-node buckets are joined up to the backup factor
+		res, fallback = res[:bucketCount], res[bucketCount:]
+
+		for i := range fallback {
+			j := i % bucketCount
+			if len(res[j]) >= maxNodesInBucket {
+				break
+			}
+
+			res[j] = append(res[j], fallback[i]...)
+		}
+	}
+
+	var nodes []*placementNode
+	for i := 0; i < bucketCount; i++ {
+		nodes = append(nodes, res[i]...)
+	}
+
+	return nodes, nil
+}
+
+// buckets returns nodes matching the selector filter grouped by the selector
+// attribute. With pivot, nodes in the buckets are sorted by weighted
+// rendezvous hashing, otherwise buckets are sorted by the attribute value or
+// node hash.
+func (c *placementContext) buckets(s *Selector) ([]placementBucket, error) {
+	var f *Filter
+
+	if name := s.GetFilter(); name != "" && name != MainFilterName {
+		var ok bool
+
+		f, ok = c.filters[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown filter %q", name)
+		}
+	}
+
+	var (
+		attr    = s.GetAttribute()
+		res     []placementBucket
+		indices = make(map[string]int)
+	)
+
+	for _, n := range c.nodes {
+		if f != nil && !c.match(f, n) {
+			continue
+		}
+
+		if attr == "" {
+			res = append(res, placementBucket{nodes: []*placementNode{n}})
+			continue
+		}
+
+		v := n.attrs[attr]
+
+		i, ok := indices[v]
+		if !ok {
+			i = len(res)
+			indices[v] = i
+			res = append(res, placementBucket{attr: v})
+		}
+
+		res[i].nodes = append(res[i].nodes, n)
+	}
+
+	if len(c.pivot) == 0 {
+		sort.Slice(res, func(i, j int) bool {
+			if attr == "" {
+				return res[i].nodes[0].hash < res[j].nodes[0].hash
+			}
+
+			return res[i].attr < res[j].attr
+		})
+
+		return res, nil
+	}
+
+	for i := range res {
+		ns := res[i].nodes
+		hashes := make([]uint64, len(ns))
+		weights := make([]float64, len(ns))
+
+		for j := range ns {
+			hashes[j], weights[j] = ns[j].hash, ns[j].weight
+		}
+
+		order := hrw.SortByWeight(hashes, weights, c.pivotHash)
+		sorted := make([]*placementNode, len(ns))
+
+		for j := range order {
+			sorted[j] = ns[order[j]]
+		}
+
+		res[i].nodes = sorted
+	}
+
+	return res, nil
+}
+
+// meanIQR returns mean of the values within the interquartile range, all
+// values are taken if there are less than 4 of them. The values are sorted.
+func meanIQR(vs []float64) float64 {
+	if len(vs) == 0 {
+		return 0
+	}
+
+	sort.Float64s(vs)
+
+	lo, hi := vs[0], vs[len(vs)-1]
+	if l := len(vs); l >= 4 {
+		lo, hi = vs[l/4], vs[l*3/4-1]
+	}
+
+	var (
+		sum   float64
+		count int
+	)
+
+	for _, v := range vs {
+		if v >= lo && v <= hi {
+			sum += v
+			count++
+		}
+	}
+
+	return sum / float64(count)
+}
@@ -0,0 +1,346 @@
+package netmap_test
+
+import (
+	"crypto/sha256"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func testNode(key byte, kvs ...string) netmap.NodeInfo {
+	attrs := make([]netmap.Attribute, len(kvs)/2)
+	for i := range attrs {
+		attrs[i].SetKey(kvs[2*i])
+		attrs[i].SetValue(kvs[2*i+1])
+	}
+
+	var n netmap.NodeInfo
+	n.SetPublicKey([]byte{key})
+	n.SetAttributes(attrs)
+	n.SetState(netmap.Online)
+
+	return n
+}
+
+func testFilter(name, key string, op netmap.Operation, val string, sub ...netmap.Filter) netmap.Filter {
+	var f netmap.Filter
+	f.SetName(name)
+	f.SetKey(key)
+	f.SetOp(op)
+	f.SetValue(val)
+	f.SetFilters(sub)
+
+	return f
+}
+
+func testSelector(name string, count uint32, clause netmap.Clause, attr, filter string) netmap.Selector {
+	var s netmap.Selector
+	s.SetName(name)
+	s.SetCount(count)
+	s.SetClause(clause)
+	s.SetAttribute(attr)
+	s.SetFilter(filter)
+
+	return s
+}
+
+func testReplica(count uint32, selector string) netmap.Replica {
+	var r netmap.Replica
+	r.SetCount(count)
+	r.SetSelector(selector)
+
+	return r
+}
+
+func testPolicy(cbf uint32, rs []netmap.Replica, ss []netmap.Selector, fs ...netmap.Filter) *netmap.PlacementPolicy {
+	var p netmap.PlacementPolicy
+	p.SetContainerBackupFactor(cbf)
+	p.SetReplicas(rs)
+	p.SetSelectors(ss)
+	p.SetFilters(fs)
+
+	return &p
+}
+
+func testNetMap() *netmap.NetMap {
+	var nodes []netmap.NodeInfo
+
+	for i, country := range []string{"RU", "RU", "RU", "DE", "DE", "DE", "FR", "FR", "US"} {
+		nodes = append(nodes, testNode(byte(i), "Country", country, "Capacity", strconv.Itoa(10*i)))
+	}
+
+	nodes[8].SetState(netmap.Offline)
+
+	var nm netmap.NetMap
+	nm.SetNodes(nodes)
+
+	return &nm
+}
+
+func nodeAttribute(n netmap.NodeInfo, key string) string {
+	for _, a := range n.GetAttributes() {
+		if a.GetKey() == key {
+			return a.GetValue()
+		}
+	}
+
+	return ""
+}
+
+func nodeKeys(ns []netmap.NodeInfo) []byte {
+	res := make([]byte, len(ns))
+	for i := range ns {
+		res[i] = ns[i].GetPublicKey()[0]
+	}
+
+	return res
+}
+
+// neofsTestNodes returns nodes used to get the reference placement from the
+// NeoFS SDK (github.com/nspcc-dev/neofs-sdk-go v1.0.0-rc.11), node public key
+// is its index.
+func neofsTestNodes() []netmap.NodeInfo {
+	var (
+		countries  = []string{"RU", "RU", "RU", "DE", "DE", "DE", "DE", "FR", "FR", "US", "US", "US", "JP", "JP", "BR", "RU"}
+		capacities = []int{10, 100, 50, 200, 30, 80, 80, 60, 5, 150, 40, 90, 70, 20, 110, 55}
+		prices     = []int{3, 5, 2, 8, 4, 4, 6, 2, 9, 3, 7, 5, 2, 6, 4, 3}
+		res        = make([]netmap.NodeInfo, len(countries))
+	)
+
+	for i := range res {
+		res[i] = testNode(byte(i),
+			"Country", countries[i],
+			"Capacity", strconv.Itoa(capacities[i]),
+			"Price", strconv.Itoa(prices[i]),
+		)
+	}
+
+	return res
+}
+
+func TestContainerNodes(t *testing.T) {
+	nm := testNetMap()
+
+	t.Run("default selector", func(t *testing.T) {
+		p := testPolicy(1, []netmap.Replica{testReplica(2, ""), testReplica(3, "")}, nil)
+
+		res, err := netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		require.Len(t, res[0], 2)
+		require.Len(t, res[1], 3)
+
+		again, err := netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Equal(t, res, again)
+
+		for i := range res {
+			for j := range res[i] {
+				require.Equal(t, netmap.Online, res[i][j].GetState())
+			}
+		}
+
+		// the order depends on the pivot
+		p = testPolicy(1, []netmap.Replica{testReplica(8, "")}, nil)
+
+		res1, err := netmap.ContainerNodes(nm, p, []byte("container1"))
+		require.NoError(t, err)
+		require.Len(t, res1[0], 8)
+
+		res2, err := netmap.ContainerNodes(nm, p, []byte("container2"))
+		require.NoError(t, err)
+		require.ElementsMatch(t, res1[0], res2[0])
+		require.NotEqual(t, res1[0], res2[0])
+	})
+
+	t.Run("distinct countries", func(t *testing.T) {
+		p := testPolicy(1, []netmap.Replica{testReplica(2, "X")},
+			[]netmap.Selector{testSelector("X", 2, netmap.Distinct, "Country", "Big")},
+			testFilter("Big", "Capacity", netmap.GE, "20"))
+
+		res, err := netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Len(t, res[0], 2)
+		require.NotEqual(t, nodeAttribute(res[0][0], "Country"), nodeAttribute(res[0][1], "Country"))
+
+		for _, n := range res[0] {
+			c, err := strconv.Atoi(nodeAttribute(n, "Capacity"))
+			require.NoError(t, err)
+			require.GreaterOrEqual(t, c, 20)
+		}
+
+		// backup factor
+		p.SetContainerBackupFactor(2)
+
+		res, err = netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Len(t, res[0], 4)
+		require.Equal(t, nodeAttribute(res[0][0], "Country"), nodeAttribute(res[0][1], "Country"))
+		require.Equal(t, nodeAttribute(res[0][2], "Country"), nodeAttribute(res[0][3], "Country"))
+	})
+
+	t.Run("same country", func(t *testing.T) {
+		p := testPolicy(1, []netmap.Replica{testReplica(3, "X")},
+			[]netmap.Selector{testSelector("X", 3, netmap.Same, "Country", "NotFR")},
+			testFilter("NotFR", "Country", netmap.NE, "FR"),
+		)
+
+		res, err := netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Len(t, res[0], 3)
+
+		country := nodeAttribute(res[0][0], "Country")
+		require.Contains(t, []string{"RU", "DE"}, country)
+
+		for _, n := range res[0] {
+			require.Equal(t, country, nodeAttribute(n, "Country"))
+		}
+	})
+
+	t.Run("compound filters", func(t *testing.T) {
+		p := testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+			[]netmap.Selector{testSelector("X", 3, netmap.Distinct, "", "F")},
+			testFilter("DE", "Country", netmap.EQ, "DE"),
+			testFilter("F", "", netmap.OR, "",
+				testFilter("DE", "", netmap.UnspecifiedOperation, ""),
+				testFilter("", "", netmap.AND, "",
+					testFilter("", "Country", netmap.EQ, "RU"),
+					testFilter("", "Capacity", netmap.LT, "10"),
+				),
+			),
+		)
+
+		res, err := netmap.ContainerNodes(nm, p, []byte("container"))
+		require.NoError(t, err)
+		require.Len(t, res[0], 3)
+
+		for _, n := range res[0] {
+			if nodeAttribute(n, "Country") != "DE" {
+				require.Equal(t, []byte{0}, n.GetPublicKey())
+			}
+		}
+	})
+
+	t.Run("missing numeric attributes", func(t *testing.T) {
+		var nm netmap.NetMap
+		nm.SetNodes([]netmap.NodeInfo{
+			testNode(0),
+			testNode(1, "Capacity", "20", "Price", "5"),
+			testNode(2, "Capacity", "big", "Price", "5", "Size", "1"),
+		})
+
+		for _, tc := range []struct {
+			f    netmap.Filter
+			keys []byte
+		}{
+			{testFilter("F", "Capacity", netmap.LT, "10"), []byte{0, 2}},
+			{testFilter("F", "Price", netmap.LE, "0"), []byte{0}},
+			{testFilter("F", "Size", netmap.GE, "0"), []byte{2}},
+		} {
+			p := testPolicy(1, []netmap.Replica{testReplica(3, "X")},
+				[]netmap.Selector{testSelector("X", 3, netmap.Distinct, "", "F")}, tc.f)
+
+			_, err := netmap.ContainerNodes(&nm, p, nil)
+			require.ErrorIs(t, err, netmap.ErrNotEnoughNodes)
+
+			p.GetSelectors()[0].SetCount(uint32(len(tc.keys)))
+
+			res, err := netmap.ContainerNodes(&nm, p, nil)
+			require.NoError(t, err)
+			require.ElementsMatch(t, tc.keys, nodeKeys(res[0]))
+		}
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		for _, p := range []*netmap.PlacementPolicy{
+			testPolicy(1, []netmap.Replica{testReplica(9, "")}, nil),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+				[]netmap.Selector{testSelector("X", 4, netmap.Distinct, "Country", "")}),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+				[]netmap.Selector{testSelector("X", 4, netmap.Same, "Country", "")}),
+		} {
+			_, err := netmap.ContainerNodes(nm, p, nil)
+			require.True(t, errors.Is(err, netmap.ErrNotEnoughNodes), err)
+		}
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		for _, p := range []*netmap.PlacementPolicy{
+			testPolicy(1, nil, nil),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")}, nil),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+				[]netmap.Selector{testSelector("X", 1, netmap.Distinct, "", "F")}),
+			testPolicy(1, []netmap.Replica{testReplica(1, "")}, nil,
+				testFilter("", "Country", netmap.EQ, "RU")),
+			testPolicy(1, []netmap.Replica{testReplica(1, "")}, nil,
+				testFilter("F", "Capacity", netmap.GT, "big")),
+			testPolicy(1, []netmap.Replica{testReplica(1, "")}, nil,
+				testFilter("F", "", netmap.AND, "", testFilter("G", "", netmap.UnspecifiedOperation, ""))),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+				[]netmap.Selector{testSelector("X", 1, netmap.Distinct, "", ""), testSelector("X", 1, netmap.Distinct, "", "")}),
+			testPolicy(1, []netmap.Replica{testReplica(1, "X")},
+				[]netmap.Selector{testSelector("X", 0, netmap.Distinct, "", "")}),
+		} {
+			_, err := netmap.ContainerNodes(nm, p, nil)
+			require.Error(t, err)
+			require.False(t, errors.Is(err, netmap.ErrNotEnoughNodes), err)
+		}
+	})
+}
+
+func TestContainerNodesNeoFS(t *testing.T) {
+	var nm netmap.NetMap
+	nm.SetNodes(neofsTestNodes())
+
+	cnr := sha256.Sum256([]byte("container"))
+
+	// reference vectors are NetMap.ContainerNodes results of the NeoFS SDK
+	// (github.com/nspcc-dev/neofs-sdk-go v1.0.0-rc.11)
+	for _, tc := range []struct {
+		name    string
+		policy  *netmap.PlacementPolicy
+		vectors [][]byte
+	}{
+		{
+			name:    "default selector",
+			policy:  testPolicy(1, []netmap.Replica{testReplica(3, "")}, nil),
+			vectors: [][]byte{{12, 2, 5}},
+		},
+		{
+			name: "distinct with filter",
+			policy: testPolicy(2, []netmap.Replica{testReplica(1, "X"), testReplica(2, "Y")},
+				[]netmap.Selector{
+					testSelector("X", 2, netmap.Distinct, "Country", "*"),
+					testSelector("Y", 3, netmap.Distinct, "", "Big"),
+				},
+				testFilter("Big", "Capacity", netmap.GE, "50"),
+			),
+			vectors: [][]byte{{12, 13, 11, 9}, {12, 11, 2, 3, 5, 15}},
+		},
+		{
+			name: "same",
+			policy: testPolicy(1, []netmap.Replica{testReplica(3, "X")},
+				[]netmap.Selector{testSelector("X", 3, netmap.Same, "Country", "*")}),
+			vectors: [][]byte{{11, 9, 10}},
+		},
+		{
+			name: "default backup factor",
+			policy: testPolicy(0, []netmap.Replica{testReplica(2, "X")},
+				[]netmap.Selector{testSelector("X", 2, netmap.Distinct, "Country", "*")}),
+			vectors: [][]byte{{11, 9, 10, 5, 3, 4}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			vectors, err := netmap.ContainerNodes(&nm, tc.policy, cnr[:])
+			require.NoError(t, err)
+			require.Len(t, vectors, len(tc.vectors))
+
+			for i := range vectors {
+				require.Equal(t, tc.vectors[i], nodeKeys(vectors[i]), i)
+			}
+		})
+	}
+}
@@ -0,0 +1,752 @@
+package netmap
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/lex"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+const (
+	policyKeywordRep      = "REP"
+	policyKeywordIn       = "IN"
+	policyKeywordCBF      = "CBF"
+	policyKeywordSubnet   = "SUBNET"
+	policyKeywordSelect   = "SELECT"
+	policyKeywordFrom     = "FROM"
+	policyKeywordAs       = "AS"
+	policyKeywordFilter   = "FILTER"
+	policyKeywordSame     = "SAME"
+	policyKeywordDistinct = "DISTINCT"
+	policyKeywordAnd      = "AND"
+	policyKeywordOr       = "OR"
+)
+
+var policyOperations = []struct {
+	kw string
+	op Operation
+}{
+	{"EQ", EQ},
+	{"NE", NE},
+	{"GT", GT},
+	{"GE", GE},
+	{"LT", LT},
+	{"LE", LE},
+}
+
+var policyKeywords = []string{
+	policyKeywordRep, policyKeywordIn, policyKeywordCBF, policyKeywordSubnet,
+	policyKeywordSelect, policyKeywordFrom, policyKeywordAs, policyKeywordFilter,
+	policyKeywordSame, policyKeywordDistinct, policyKeywordAnd, policyKeywordOr,
+	"EQ", "NE", "GT", "GE", "LT", "LE",
+}
+
+// PolicySyntaxError describes placement policy syntax error.
+type PolicySyntaxError struct {
+	// Line is a 1-based line number of the error in the policy.
+	Line int
+
+	// Column is a 1-based position (in characters) of the error in the line.
+	Column int
+
+	// Message describes the error.
+	Message string
+}
+
+func (x *PolicySyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at %d:%d: %s", x.Line, x.Column, x.Message)
+}
+
+type policyTokenKind uint8
+
+const (
+	policyTokenEOF policyTokenKind = iota
+	policyTokenWord
+	policyTokenString
+	policyTokenPunct
+)
+
+type policyToken struct {
+	kind policyTokenKind
+	text string // unquoted for strings
+	pos  int    // byte offset
+}
+
+type policyParser struct {
+	src string
+	pos int
+
+	tok policyToken
+}
+
+func isPolicyBareChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.ContainsRune("_.:$-/*", c)
+}
+
+func isPolicyKeyword(s string) bool {
+	for _, kw := range policyKeywords {
+		if strings.EqualFold(s, kw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (x *policyParser) errorf(pos int, format string, args ...any) error {
+	line := strings.Count(x.src[:pos], "\n")
+	lineStart := strings.LastIndexByte(x.src[:pos], '\n') + 1
+
+	return &PolicySyntaxError{
+		Line:    line + 1,
+		Column:  utf8.RuneCountInString(x.src[lineStart:pos]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// next reads the next token into x.tok.
+func (x *policyParser) next() error {
+	for x.pos < len(x.src) && strings.IndexByte(" \t\r\n", x.src[x.pos]) >= 0 {
+		x.pos++
+	}
+
+	start := x.pos
+
+	if x.pos == len(x.src) {
+		x.tok = policyToken{kind: policyTokenEOF, pos: start}
+		return nil
+	}
+
+	c, _ := utf8.DecodeRuneInString(x.src[x.pos:])
+
+	switch {
+	case c == '"':
+		s, n, err := lex.QuotedString(x.src[x.pos:])
+		if err != nil {
+			return x.errorf(start, "%v", err)
+		}
+
+		x.pos += n
+		x.tok = policyToken{kind: policyTokenString, text: s, pos: start}
+	case isPolicyBareChar(c):
+		for x.pos < len(x.src) && isPolicyBareChar(rune(x.src[x.pos])) {
+			x.pos++
+		}
+
+		x.tok = policyToken{kind: policyTokenWord, text: x.src[start:x.pos], pos: start}
+	case c == '(' || c == ')' || c == '@':
+		x.pos++
+		x.tok = policyToken{kind: policyTokenPunct, text: string(c), pos: start}
+	default:
+		return x.errorf(start, "unexpected character %q", c)
+	}
+
+	return nil
+}
+
+func (x policyToken) isKeyword(kw string) bool {
+	return x.kind == policyTokenWord && strings.EqualFold(x.text, kw)
+}
+
+func (x policyToken) isPunct(p string) bool {
+	return x.kind == policyTokenPunct && x.text == p
+}
+
+func (x policyToken) isIdent() bool {
+	return x.kind == policyTokenString || x.kind == policyTokenWord && !isPolicyKeyword(x.text)
+}
+
+func (x policyToken) String() string {
+	switch x.kind {
+	default:
+		return fmt.Sprintf("%q", x.text)
+	case policyTokenEOF:
+		return "end of policy"
+	case policyTokenString:
+		return "string " + strconv.Quote(x.text)
+	}
+}
+
+// expectKeyword checks that the current token is the keyword and reads the
+// next one.
+func (x *policyParser) expectKeyword(kw string) error {
+	if !x.tok.isKeyword(kw) {
+		return x.errorf(x.tok.pos, "expected %s, got %s", kw, x.tok)
+	}
+
+	return x.next()
+}
+
+// ident reads an identifier, key or value.
+func (x *policyParser) ident(what string) (string, error) {
+	if !x.tok.isIdent() {
+		return "", x.errorf(x.tok.pos, "expected %s, got %s", what, x.tok)
+	}
+
+	s := x.tok.text
+
+	return s, x.next()
+}
+
+// number reads a base-10 uint32.
+func (x *policyParser) number(what string) (uint32, error) {
+	if x.tok.kind != policyTokenWord {
+		return 0, x.errorf(x.tok.pos, "expected %s, got %s", what, x.tok)
+	}
+
+	n, err := strconv.ParseUint(x.tok.text, 10, 32)
+	if err != nil {
+		return 0, x.errorf(x.tok.pos, "invalid %s %q", what, x.tok.text)
+	}
+
+	return uint32(n), x.next()
+}
+
+// ParsePlacementPolicy parses the placement policy written in the policy
+// language:
+//
+//	Policy   ::= Replica+ ('CBF' Number)? ('SUBNET' Number)? Selector* Filter*
+//	Replica  ::= 'REP' Number ('IN' Ident)?
+//	Selector ::= 'SELECT' Number ('IN' ('SAME' | 'DISTINCT')? Ident?)? ('FROM' Ident)? ('AS' Ident)?
+//	Filter   ::= 'FILTER' OrExpr 'AS' Ident
+//	OrExpr   ::= AndExpr ('OR' AndExpr)*
+//	AndExpr  ::= Expr ('AND' Expr)*
+//	Expr     ::= '@' Ident | '(' OrExpr ')' | Ident Op Ident
+//	Op       ::= 'EQ' | 'NE' | 'GT' | 'GE' | 'LT' | 'LE'
+//
+// where '@' references the filter by name (MainFilterName matches all nodes).
+// Identifiers are either bare words of letters, digits and "_.:$-/*"
+// characters or double-quoted Go string literals. Keywords are
+// case-insensitive, so identifiers matching them must be quoted.
+//
+// Example:
+//
+//	REP 3 IN X
+//	CBF 2
+//	SELECT 3 IN DISTINCT Country FROM F AS X
+//	FILTER Continent EQ Europe AND (Price LE 10 OR @Premium) AS F
+//
+// ParsePlacementPolicy checks the syntax only, use ValidatePlacementPolicy to
+// check the semantics. Returns *PolicySyntaxError if the policy is malformed.
+func ParsePlacementPolicy(s string) (*PlacementPolicy, error) {
+	var (
+		x         = policyParser{src: s}
+		p         PlacementPolicy
+		replicas  []Replica
+		selectors []Selector
+		filters   []Filter
+	)
+
+	if err := x.next(); err != nil {
+		return nil, err
+	}
+
+	for x.tok.isKeyword(policyKeywordRep) {
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		var r Replica
+
+		n, err := x.number("replica count")
+		if err != nil {
+			return nil, err
+		}
+
+		r.SetCount(n)
+
+		if x.tok.isKeyword(policyKeywordIn) {
+			if err = x.next(); err != nil {
+				return nil, err
+			}
+
+			sel, err := x.ident("selector name")
+			if err != nil {
+				return nil, err
+			}
+
+			r.SetSelector(sel)
+		}
+
+		replicas = append(replicas, r)
+	}
+
+	if len(replicas) == 0 {
+		return nil, x.errorf(x.tok.pos, "expected %s, got %s", policyKeywordRep, x.tok)
+	}
+
+	p.SetReplicas(replicas)
+
+	if x.tok.isKeyword(policyKeywordCBF) {
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		n, err := x.number("backup factor")
+		if err != nil {
+			return nil, err
+		}
+
+		p.SetContainerBackupFactor(n)
+	}
+
+	if x.tok.isKeyword(policyKeywordSubnet) {
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		n, err := x.number("subnet ID")
+		if err != nil {
+			return nil, err
+		}
+
+		var id refs.SubnetID
+		id.SetValue(n)
+
+		p.SetSubnetID(&id)
+	}
+
+	for x.tok.isKeyword(policyKeywordSelect) {
+		s, err := x.selector()
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, *s)
+	}
+
+	p.SetSelectors(selectors)
+
+	for x.tok.isKeyword(policyKeywordFilter) {
+		f, err := x.filter()
+		if err != nil {
+			return nil, err
+		}
+
+		filters = append(filters, *f)
+	}
+
+	p.SetFilters(filters)
+
+	if x.tok.kind != policyTokenEOF {
+		return nil, x.errorf(x.tok.pos, "expected %s, %s or end of policy, got %s",
+			policyKeywordSelect, policyKeywordFilter, x.tok)
+	}
+
+	return &p, nil
+}
+
+func (x *policyParser) selector() (*Selector, error) {
+	if err := x.next(); err != nil {
+		return nil, err
+	}
+
+	var s Selector
+
+	n, err := x.number("node count")
+	if err != nil {
+		return nil, err
+	}
+
+	s.SetCount(n)
+
+	if x.tok.isKeyword(policyKeywordIn) {
+		inPos := x.tok.pos
+
+		if err = x.next(); err != nil {
+			return nil, err
+		}
+
+		switch {
+		case x.tok.isKeyword(policyKeywordSame):
+			s.SetClause(Same)
+		case x.tok.isKeyword(policyKeywordDistinct):
+			s.SetClause(Distinct)
+		}
+
+		if s.GetClause() != UnspecifiedClause {
+			if err = x.next(); err != nil {
+				return nil, err
+			}
+		}
+
+		if x.tok.isIdent() {
+			s.SetAttribute(x.tok.text)
+
+			if err = x.next(); err != nil {
+				return nil, err
+			}
+		} else if s.GetClause() == UnspecifiedClause {
+			return nil, x.errorf(inPos, "expected clause or attribute after %s, got %s", policyKeywordIn, x.tok)
+		}
+	}
+
+	if x.tok.isKeyword(policyKeywordFrom) {
+		if err = x.next(); err != nil {
+			return nil, err
+		}
+
+		f, err := x.ident("filter name")
+		if err != nil {
+			return nil, err
+		}
+
+		s.SetFilter(f)
+	}
+
+	if x.tok.isKeyword(policyKeywordAs) {
+		if err = x.next(); err != nil {
+			return nil, err
+		}
+
+		name, err := x.ident("selector name")
+		if err != nil {
+			return nil, err
+		}
+
+		s.SetName(name)
+	}
+
+	return &s, nil
+}
+
+func (x *policyParser) filter() (*Filter, error) {
+	if err := x.next(); err != nil {
+		return nil, err
+	}
+
+	exprPos := x.tok.pos
+
+	f, err := x.orExpr()
+	if err != nil {
+		return nil, err
+	}
+
+	if f.GetOp() == UnspecifiedOperation {
+		return nil, x.errorf(exprPos, "filter can not be a reference only")
+	}
+
+	if err = x.expectKeyword(policyKeywordAs); err != nil {
+		return nil, err
+	}
+
+	name, err := x.ident("filter name")
+	if err != nil {
+		return nil, err
+	}
+
+	f.SetName(name)
+
+	return f, nil
+}
+
+// orExpr and andExpr parse chains of sub-expressions joined by OR and AND
+// respectively. Single sub-expression is returned as is.
+func (x *policyParser) orExpr() (*Filter, error) {
+	return x.chain(OR, policyKeywordOr, x.andExpr)
+}
+
+func (x *policyParser) andExpr() (*Filter, error) {
+	return x.chain(AND, policyKeywordAnd, x.expr)
+}
+
+func (x *policyParser) chain(op Operation, kw string, sub func() (*Filter, error)) (*Filter, error) {
+	f, err := sub()
+	if err != nil {
+		return nil, err
+	}
+
+	if !x.tok.isKeyword(kw) {
+		return f, nil
+	}
+
+	fs := []Filter{*f}
+
+	for x.tok.isKeyword(kw) {
+		if err = x.next(); err != nil {
+			return nil, err
+		}
+
+		if f, err = sub(); err != nil {
+			return nil, err
+		}
+
+		fs = append(fs, *f)
+	}
+
+	var res Filter
+	res.SetOp(op)
+	res.SetFilters(fs)
+
+	return &res, nil
+}
+
+func (x *policyParser) expr() (*Filter, error) {
+	var f Filter
+
+	switch {
+	case x.tok.isPunct("@"):
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		name, err := x.ident("filter name")
+		if err != nil {
+			return nil, err
+		}
+
+		f.SetName(name)
+	case x.tok.isPunct("("):
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		res, err := x.orExpr()
+		if err != nil {
+			return nil, err
+		}
+
+		if !x.tok.isPunct(")") {
+			return nil, x.errorf(x.tok.pos, "expected \")\", got %s", x.tok)
+		}
+
+		return res, x.next()
+	case x.tok.isIdent():
+		f.SetKey(x.tok.text)
+
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		for i := range policyOperations {
+			if x.tok.isKeyword(policyOperations[i].kw) {
+				f.SetOp(policyOperations[i].op)
+				break
+			}
+		}
+
+		if f.GetOp() == UnspecifiedOperation {
+			return nil, x.errorf(x.tok.pos, "expected operation, got %s", x.tok)
+		}
+
+		if err := x.next(); err != nil {
+			return nil, err
+		}
+
+		val, err := x.ident("value")
+		if err != nil {
+			return nil, err
+		}
+
+		f.SetValue(val)
+	default:
+		return nil, x.errorf(x.tok.pos, "expected filter expression, got %s", x.tok)
+	}
+
+	return &f, nil
+}
+
+// FormatPlacementPolicy formats the placement policy into the canonical text
+// which can be parsed back by ParsePlacementPolicy: one statement per line,
+// identifiers are quoted only if needed, parentheses are used only if needed
+// to keep the filter structure.
+//
+// Returns an error if the policy can not be expressed in the policy language:
+// it has no replicas, unknown clause or operation, or filters of unsupported
+// structure (e.g. sub-filters of the comparison filters, AND and OR filters
+// with less than two sub-filters, named sub-filters).
+func FormatPlacementPolicy(p *PlacementPolicy) (string, error) {
+	var lines []string
+
+	replicas := p.GetReplicas()
+	if len(replicas) == 0 {
+		return "", errors.New("no replicas")
+	}
+
+	for i := range replicas {
+		line := policyKeywordRep + " " + strconv.FormatUint(uint64(replicas[i].GetCount()), 10)
+		if sel := replicas[i].GetSelector(); sel != "" {
+			line += " " + policyKeywordIn + " " + quotePolicyWord(sel)
+		}
+
+		lines = append(lines, line)
+	}
+
+	if cbf := p.GetContainerBackupFactor(); cbf != 0 {
+		lines = append(lines, policyKeywordCBF+" "+strconv.FormatUint(uint64(cbf), 10))
+	}
+
+	if id := p.GetSubnetID(); id != nil {
+		lines = append(lines, policyKeywordSubnet+" "+strconv.FormatUint(uint64(id.GetValue()), 10))
+	}
+
+	selectors := p.GetSelectors()
+
+	for i := range selectors {
+		line, err := formatSelector(&selectors[i])
+		if err != nil {
+			return "", fmt.Errorf("selector #%d: %w", i, err)
+		}
+
+		lines = append(lines, line)
+	}
+
+	filters := p.GetFilters()
+
+	for i := range filters {
+		f := &filters[i]
+
+		if f.GetOp() == UnspecifiedOperation {
+			return "", fmt.Errorf("filter #%d: reference can not be a top-level filter", i)
+		}
+
+		var sb strings.Builder
+
+		sb.WriteString(policyKeywordFilter + " ")
+
+		err := formatFilterExpr(&sb, f)
+		if err != nil {
+			return "", fmt.Errorf("filter #%d: %w", i, err)
+		}
+
+		sb.WriteString(" " + policyKeywordAs + " " + quotePolicyWord(f.GetName()))
+
+		lines = append(lines, sb.String())
+	}
+
+	return strings.Join(lines, "\n"), nil
+}
+
+func formatSelector(s *Selector) (string, error) {
+	var sb strings.Builder
+
+	sb.WriteString(policyKeywordSelect + " " + strconv.FormatUint(uint64(s.GetCount()), 10))
+
+	var clause string
+
+	switch c := s.GetClause(); c {
+	default:
+		return "", fmt.Errorf("unsupported clause %s", c)
+	case UnspecifiedClause:
+	case Same:
+		clause = policyKeywordSame
+	case Distinct:
+		clause = policyKeywordDistinct
+	}
+
+	if attr := s.GetAttribute(); clause != "" || attr != "" {
+		sb.WriteString(" " + policyKeywordIn)
+
+		if clause != "" {
+			sb.WriteString(" " + clause)
+		}
+
+		if attr != "" {
+			sb.WriteString(" " + quotePolicyWord(attr))
+		}
+	}
+
+	if f := s.GetFilter(); f != "" {
+		sb.WriteString(" " + policyKeywordFrom + " " + quotePolicyWord(f))
+	}
+
+	if name := s.GetName(); name != "" {
+		sb.WriteString(" " + policyKeywordAs + " " + quotePolicyWord(name))
+	}
+
+	return sb.String(), nil
+}
+
+// formatFilterExpr writes the filter expression without the name of the
+// top-level filter.
+func formatFilterExpr(sb *strings.Builder, f *Filter) error {
+	switch op := f.GetOp(); op {
+	default:
+		return fmt.Errorf("unsupported operation %s", op)
+	case UnspecifiedOperation:
+		if f.GetKey() != "" || f.GetValue() != "" || len(f.GetFilters()) != 0 {
+			return fmt.Errorf("reference to filter %q has key, value or sub-filters", f.GetName())
+		}
+
+		sb.WriteString("@" + quotePolicyWord(f.GetName()))
+	case EQ, NE, GT, GE, LT, LE:
+		if len(f.GetFilters()) != 0 {
+			return fmt.Errorf("sub-filters are not supported by %s operation", op)
+		}
+
+		var kw string
+
+		for i := range policyOperations {
+			if policyOperations[i].op == op {
+				kw = policyOperations[i].kw
+				break
+			}
+		}
+
+		sb.WriteString(quotePolicyWord(f.GetKey()) + " " + kw + " " + quotePolicyWord(f.GetValue()))
+	case AND, OR:
+		if f.GetKey() != "" || f.GetValue() != "" {
+			return fmt.Errorf("key and value are not supported by %s operation", op)
+		}
+
+		sub := f.GetFilters()
+		if len(sub) < 2 {
+			return fmt.Errorf("%s operation requires at least 2 sub-filters", op)
+		}
+
+		kw := policyKeywordAnd
+		if op == OR {
+			kw = policyKeywordOr
+		}
+
+		for i := range sub {
+			if i > 0 {
+				sb.WriteString(" " + kw + " ")
+			}
+
+			subOp := sub[i].GetOp()
+
+			if subOp != UnspecifiedOperation && sub[i].GetName() != "" {
+				return fmt.Errorf("sub-filter #%d: named sub-filters are not supported", i)
+			}
+
+			// AND binds tighter than OR, same operations are nested explicitly
+			parens := subOp == op || subOp == OR
+
+			if parens {
+				sb.WriteString("(")
+			}
+
+			err := formatFilterExpr(sb, &sub[i])
+			if err != nil {
+				return fmt.Errorf("sub-filter #%d: %w", i, err)
+			}
+
+			if parens {
+				sb.WriteString(")")
+			}
+		}
+	}
+
+	return nil
+}
+
+// quotePolicyWord quotes s if it can not be written as a bare word.
+func quotePolicyWord(s string) string {
+	if s == "" || isPolicyKeyword(s) {
+		return strconv.Quote(s)
+	}
+
+	for _, c := range s {
+		if !isPolicyBareChar(c) {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
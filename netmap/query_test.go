@@ -0,0 +1,180 @@
+package netmap_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	netmaptest "github.com/nspcc-dev/neofs-api-go/v2/netmap/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlacementPolicy(t *testing.T) {
+	p, err := netmap.ParsePlacementPolicy(`REP 3 IN X CBF 2 SELECT 3 IN DISTINCT Country FROM F FILTER Continent EQ Europe AS F`)
+	require.NoError(t, err)
+
+	exp := testPolicy(2, []netmap.Replica{testReplica(3, "X")},
+		[]netmap.Selector{testSelector("", 3, netmap.Distinct, "Country", "F")},
+		testFilter("F", "Continent", netmap.EQ, "Europe"))
+	require.Equal(t, exp, p)
+
+	p, err = netmap.ParsePlacementPolicy(`
+		rep 1
+		REP 2 IN "my selector"
+		SUBNET 17
+		SELECT 1 IN SAME FROM * AS "my selector"
+		SELECT 2 IN City
+		FILTER A EQ 1 OR B NE "two words" AND (C GT 3 OR D GE 4) AND @* AS F
+		FILTER (@F AND Price LE 10) AND Rating LT 5 AS G
+	`)
+	require.NoError(t, err)
+
+	exp = testPolicy(0, []netmap.Replica{testReplica(1, ""), testReplica(2, "my selector")},
+		[]netmap.Selector{
+			testSelector("my selector", 1, netmap.Same, "", "*"),
+			testSelector("", 2, netmap.UnspecifiedClause, "City", ""),
+		},
+		testFilter("F", "", netmap.OR, "",
+			testFilter("", "A", netmap.EQ, "1"),
+			testFilter("", "", netmap.AND, "",
+				testFilter("", "B", netmap.NE, "two words"),
+				testFilter("", "", netmap.OR, "",
+					testFilter("", "C", netmap.GT, "3"),
+					testFilter("", "D", netmap.GE, "4"),
+				),
+				testFilter("*", "", netmap.UnspecifiedOperation, ""),
+			),
+		),
+		testFilter("G", "", netmap.AND, "",
+			testFilter("", "", netmap.AND, "",
+				testFilter("F", "", netmap.UnspecifiedOperation, ""),
+				testFilter("", "Price", netmap.LE, "10"),
+			),
+			testFilter("", "Rating", netmap.LT, "5"),
+		),
+	)
+	exp.SetSubnetID(p.GetSubnetID())
+	require.Equal(t, exp, p)
+	require.EqualValues(t, 17, p.GetSubnetID().GetValue())
+
+	for _, tc := range []struct {
+		s            string
+		line, column int
+	}{
+		{"", 1, 1},
+		{"SELECT 1", 1, 1},
+		{"REP", 1, 4},
+		{"REP x", 1, 5},
+		{"REP 4294967296", 1, 5},
+		{"REP 1 IN", 1, 9},
+		{"REP 1 IN SELECT", 1, 10},
+		{"REP 1\nCBF -1", 2, 5},
+		{"REP 1\nSELECT 1 IN FROM F", 2, 10},
+		{"REP 1\nSELECT 1 FROM", 2, 14},
+		{"REP 1\nFILTER A EQ B", 2, 14},
+		{"REP 1\nFILTER A B C AS F", 2, 10},
+		{"REP 1\nFILTER A EQ AS F", 2, 13},
+		{"REP 1\nFILTER (A EQ B AS F", 2, 16},
+		{"REP 1\nFILTER @G AS F", 2, 8},
+		{"REP 1\nFILTER A EQ \"B AS F", 2, 13},
+		{"REP 1\nFILTER A EQ B AS F\nSELECT 1", 3, 1},
+		{"REP 1 # comment", 1, 7},
+	} {
+		_, err := netmap.ParsePlacementPolicy(tc.s)
+
+		var e *netmap.PolicySyntaxError
+		require.True(t, errors.As(err, &e), tc.s)
+		require.Equal(t, tc.line, e.Line, tc.s)
+		require.Equal(t, tc.column, e.Column, tc.s)
+	}
+}
+
+func TestFormatPlacementPolicy(t *testing.T) {
+	const s = `REP 3 IN X
+REP 1
+CBF 2
+SELECT 3 IN DISTINCT Country FROM F AS X
+SELECT 1 IN SAME
+FILTER Continent EQ Europe AND (Price LE 10 OR @Premium) AND ("AND" NE "" OR A EQ B AND C EQ D) AS F
+FILTER (A EQ B OR C EQ D) OR E EQ F AS G`
+
+	p, err := netmap.ParsePlacementPolicy(s)
+	require.NoError(t, err)
+
+	res, err := netmap.FormatPlacementPolicy(p)
+	require.NoError(t, err)
+	require.Equal(t, s, res)
+
+	for _, p := range []*netmap.PlacementPolicy{
+		testPolicy(0, nil, nil),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")},
+			[]netmap.Selector{testSelector("", 1, 3, "", "")}),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "", netmap.UnspecifiedOperation, "")),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "A", 100, "B")),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "", netmap.AND, "", testFilter("", "A", netmap.EQ, "B"))),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "A", netmap.EQ, "B", testFilter("", "A", netmap.EQ, "B"))),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "", netmap.OR, "",
+				testFilter("G", "A", netmap.EQ, "B"),
+				testFilter("", "A", netmap.EQ, "B"))),
+		testPolicy(0, []netmap.Replica{testReplica(1, "")}, nil,
+			testFilter("F", "", netmap.OR, "",
+				testFilter("G", "A", netmap.UnspecifiedOperation, ""),
+				testFilter("", "A", netmap.EQ, "B"))),
+	} {
+		_, err := netmap.FormatPlacementPolicy(p)
+		require.Error(t, err)
+	}
+}
+
+func TestPlacementPolicyRoundTrip(t *testing.T) {
+	p := netmaptest.GeneratePlacementPolicy(false)
+
+	// generated filters have sub-filters unsupported by EQ operation
+	_, err := netmap.FormatPlacementPolicy(p)
+	require.Error(t, err)
+
+	fs := p.GetFilters()
+	for i := range fs {
+		fs[i].SetFilters(nil)
+	}
+
+	fs = append(fs, *netmaptest.GenerateFilter(false))
+	fs[len(fs)-1].SetOp(netmap.OR)
+	fs[len(fs)-1].SetKey("")
+	fs[len(fs)-1].SetValue("")
+
+	sub := fs[len(fs)-1].GetFilters()
+	sub[0].SetName("")
+	sub[1].SetKey("")
+	sub[1].SetValue("")
+	sub[1].SetOp(netmap.UnspecifiedOperation)
+
+	p.SetFilters(fs)
+
+	ss := append(p.GetSelectors(), *netmaptest.GenerateSelector(false))
+	ss[len(ss)-1].SetClause(netmap.Distinct)
+	ss[len(ss)-1].SetAttribute("")
+	p.SetSelectors(ss)
+
+	for _, p := range []*netmap.PlacementPolicy{
+		p,
+		testPolicy(0, netmaptest.GenerateReplicas(false), nil),
+		testPolicy(1, netmaptest.GenerateReplicas(false), netmaptest.GenerateSelectors(false)),
+	} {
+		s, err := netmap.FormatPlacementPolicy(p)
+		require.NoError(t, err)
+
+		res, err := netmap.ParsePlacementPolicy(s)
+		require.NoError(t, err, s)
+		require.Equal(t, p, res, s)
+
+		again, err := netmap.FormatPlacementPolicy(res)
+		require.NoError(t, err)
+		require.Equal(t, s, again)
+	}
+}
@@ -0,0 +1,492 @@
+package netmap
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PolicyIssueKind enumerates kinds of the problems found by
+// ValidatePlacementPolicy.
+type PolicyIssueKind uint8
+
+const (
+	_ PolicyIssueKind = iota
+
+	// PolicyIssueNoReplicas is reported for policies without replicas.
+	PolicyIssueNoReplicas
+
+	// PolicyIssueZeroCount is reported for replicas and selectors with zero
+	// node count.
+	PolicyIssueZeroCount
+
+	// PolicyIssueUndefinedSelector is reported for replicas referencing
+	// selectors missing in the policy.
+	PolicyIssueUndefinedSelector
+
+	// PolicyIssueUndefinedFilter is reported for selectors and filters
+	// referencing filters missing in the policy.
+	PolicyIssueUndefinedFilter
+
+	// PolicyIssueDuplicateName is reported for selectors and filters having
+	// the same name as an earlier one.
+	PolicyIssueDuplicateName
+
+	// PolicyIssueInvalidName is reported for unnamed top-level filters and
+	// filters named as MainFilterName.
+	PolicyIssueInvalidName
+
+	// PolicyIssueUnusedFilter is reported for top-level filters not used by
+	// any selector directly or through other filters.
+	PolicyIssueUnusedFilter
+
+	// PolicyIssueFilterCycle is reported for filters referencing themselves
+	// directly or through other filters.
+	PolicyIssueFilterCycle
+
+	// PolicyIssueForwardReference is reported for references to the filters
+	// defined later in the policy, ContainerNodes requires filters to be
+	// defined before use.
+	PolicyIssueForwardReference
+
+	// PolicyIssueCompoundKeyValue is reported for AND and OR filters with key
+	// or value set.
+	PolicyIssueCompoundKeyValue
+
+	// PolicyIssueEmptyCompound is reported for AND and OR filters without
+	// sub-filters.
+	PolicyIssueEmptyCompound
+
+	// PolicyIssueLeafSubFilters is reported for comparison filters with
+	// sub-filters, they are ignored.
+	PolicyIssueLeafSubFilters
+
+	// PolicyIssueMissingOperation is reported for top-level filters and
+	// unnamed sub-filters without operation.
+	PolicyIssueMissingOperation
+
+	// PolicyIssueUnsupportedEnum is reported for filters and selectors with
+	// unknown operation and clause respectively.
+	PolicyIssueUnsupportedEnum
+
+	// PolicyIssueNonNumericValue is reported for GT, GE, LT and LE filters
+	// with values which are not base-10 uint64.
+	PolicyIssueNonNumericValue
+)
+
+// PolicyIssue describes the problem of the placement policy found by
+// ValidatePlacementPolicy.
+type PolicyIssue struct {
+	// Kind of the problem.
+	Kind PolicyIssueKind
+
+	// Element is a path to the problematic policy element, e.g. "replica #0",
+	// "selector #1" or "filter #2: sub-filter #0". Empty for the whole policy.
+	Element string
+
+	// Message explains the problem.
+	Message string
+}
+
+func (x PolicyIssue) String() string {
+	if x.Element == "" {
+		return x.Message
+	}
+
+	return x.Element + ": " + x.Message
+}
+
+type policyFilterDef struct {
+	f       *Filter
+	element string
+	order   int // definition order, as in ContainerNodes
+	refs    []string
+}
+
+type policyValidator struct {
+	res []PolicyIssue
+
+	filters map[string]*policyFilterDef
+}
+
+func (x *policyValidator) report(kind PolicyIssueKind, element string, format string, args ...any) {
+	x.res = append(x.res, PolicyIssue{
+		Kind:    kind,
+		Element: element,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidatePlacementPolicy statically checks the placement policy without the
+// network map and returns found problems ordered by the policy element:
+// replicas, selectors and filters. The result is empty if no problems are
+// found. Policy without problems is accepted by ContainerNodes, however it
+// may still be unsatisfiable by the particular network map (see
+// PlacementShortages).
+//
+// Named sub-filters with operation set define filters as top-level ones do,
+// sub-filters without operation reference them by name. MainFilterName
+// references all nodes and can not be defined.
+func ValidatePlacementPolicy(p *PlacementPolicy) []PolicyIssue {
+	x := policyValidator{
+		filters: make(map[string]*policyFilterDef),
+	}
+
+	var (
+		selectors = p.GetSelectors()
+		filters   = p.GetFilters()
+		selNames  = make(map[string]struct{}, len(selectors))
+		order     int
+	)
+
+	// collect filter definitions first to distinguish undefined filters from
+	// the ones defined later
+	for i := range filters {
+		x.defineFilters(&filters[i], fmt.Sprintf("filter #%d", i), true, &order)
+	}
+
+	replicas := p.GetReplicas()
+	if len(replicas) == 0 {
+		x.report(PolicyIssueNoReplicas, "", "no replicas")
+	}
+
+	for i := range selectors {
+		if name := selectors[i].GetName(); name != "" {
+			selNames[name] = struct{}{}
+		}
+	}
+
+	for i := range replicas {
+		element := "replica #" + strconv.Itoa(i)
+
+		if replicas[i].GetCount() == 0 {
+			x.report(PolicyIssueZeroCount, element, "zero node count")
+		}
+
+		if name := replicas[i].GetSelector(); name != "" {
+			if _, ok := selNames[name]; !ok {
+				x.report(PolicyIssueUndefinedSelector, element, "undefined selector %q", name)
+			}
+		}
+	}
+
+	used := make(map[string]struct{})
+	seenSelectors := make(map[string]struct{}, len(selectors))
+
+	for i := range selectors {
+		s := &selectors[i]
+		element := "selector #" + strconv.Itoa(i)
+
+		if name := s.GetName(); name != "" {
+			if _, ok := seenSelectors[name]; ok {
+				x.report(PolicyIssueDuplicateName, element, "duplicated selector %q", name)
+			}
+
+			seenSelectors[name] = struct{}{}
+		}
+
+		if s.GetCount() == 0 {
+			x.report(PolicyIssueZeroCount, element, "zero node count")
+		}
+
+		switch c := s.GetClause(); c {
+		default:
+			x.report(PolicyIssueUnsupportedEnum, element, "unsupported clause %s", c)
+		case UnspecifiedClause, Same, Distinct:
+		}
+
+		switch name := s.GetFilter(); name {
+		case "", MainFilterName:
+		default:
+			if _, ok := x.filters[name]; !ok {
+				x.report(PolicyIssueUndefinedFilter, element, "undefined filter %q", name)
+			} else {
+				x.markUsed(name, used)
+			}
+		}
+	}
+
+	order = 0
+
+	for i := range filters {
+		x.checkFilter(&filters[i], fmt.Sprintf("filter #%d", i), true, &order)
+	}
+
+	for i := range filters {
+		def, ok := x.filters[filters[i].GetName()]
+		if !ok || def.f != &filters[i] {
+			continue
+		}
+
+		if _, ok := used[filters[i].GetName()]; !ok {
+			x.report(PolicyIssueUnusedFilter, def.element, "filter %q is not used by any selector", filters[i].GetName())
+		}
+	}
+
+	return x.res
+}
+
+// defineFilters remembers the first definition of each named filter in the
+// same order as ContainerNodes does: sub-filters go first.
+func (x *policyValidator) defineFilters(f *Filter, element string, top bool, order *int) []string {
+	var refs []string
+
+	sub := f.GetFilters()
+
+	for i := range sub {
+		refs = append(refs, x.defineFilters(&sub[i], fmt.Sprintf("%s: sub-filter #%d", element, i), false, order)...)
+	}
+
+	name := f.GetName()
+
+	if f.GetOp() == UnspecifiedOperation {
+		if !top && name != "" && name != MainFilterName {
+			return []string{name}
+		}
+
+		return nil
+	}
+
+	if name == "" || name == MainFilterName {
+		return refs
+	}
+
+	if _, ok := x.filters[name]; !ok {
+		x.filters[name] = &policyFilterDef{
+			f:       f,
+			element: element,
+			order:   *order,
+			refs:    refs,
+		}
+	}
+
+	*order++
+
+	// the parent filter references this one
+	return []string{name}
+}
+
+func (x *policyValidator) markUsed(name string, used map[string]struct{}) {
+	if _, ok := used[name]; ok {
+		return
+	}
+
+	used[name] = struct{}{}
+
+	if def, ok := x.filters[name]; ok {
+		for _, ref := range def.refs {
+			x.markUsed(ref, used)
+		}
+	}
+}
+
+// reaches checks if the filter references the target one directly or through
+// other filters.
+func (x *policyValidator) reaches(from, to string, visited map[string]struct{}) bool {
+	def, ok := x.filters[from]
+	if !ok {
+		return false
+	}
+
+	for _, ref := range def.refs {
+		if ref == to {
+			return true
+		}
+
+		if _, ok := visited[ref]; ok {
+			continue
+		}
+
+		visited[ref] = struct{}{}
+
+		if x.reaches(ref, to, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkFilter reports problems of the filter and its sub-filters. Order is
+// the number of filters defined before f in ContainerNodes.
+func (x *policyValidator) checkFilter(f *Filter, element string, top bool, order *int) {
+	name, op := f.GetName(), f.GetOp()
+	sub := f.GetFilters()
+
+	if op == UnspecifiedOperation && !top {
+		switch {
+		case name == "":
+			x.report(PolicyIssueMissingOperation, element, "unnamed sub-filter without operation")
+		case name == MainFilterName:
+		default:
+			def, ok := x.filters[name]
+
+			switch {
+			case !ok:
+				x.report(PolicyIssueUndefinedFilter, element, "undefined filter %q", name)
+			case def.order >= *order:
+				if x.reaches(name, name, make(map[string]struct{})) {
+					x.report(PolicyIssueFilterCycle, element, "filter %q is in a reference cycle", name)
+				} else {
+					x.report(PolicyIssueForwardReference, element, "filter %q is referenced before definition", name)
+				}
+			}
+		}
+
+		if f.GetKey() != "" || f.GetValue() != "" || len(sub) != 0 {
+			x.report(PolicyIssueMissingOperation, element, "filter %q reference with key, value or sub-filters", name)
+		}
+
+		return
+	}
+
+	if top && name == "" {
+		x.report(PolicyIssueInvalidName, element, "unnamed top-level filter")
+	}
+
+	if name == MainFilterName {
+		x.report(PolicyIssueInvalidName, element, "reserved filter name %q", name)
+	}
+
+	if name != "" && name != MainFilterName {
+		if def, ok := x.filters[name]; ok && def.f != f {
+			x.report(PolicyIssueDuplicateName, element, "duplicated filter %q, first defined in %s", name, def.element)
+		}
+	}
+
+	switch op {
+	default:
+		x.report(PolicyIssueUnsupportedEnum, element, "unsupported operation %s", op)
+	case UnspecifiedOperation:
+		x.report(PolicyIssueMissingOperation, element, "top-level filter without operation")
+	case EQ, NE:
+	case GT, GE, LT, LE:
+		if _, err := strconv.ParseUint(f.GetValue(), 10, 64); err != nil {
+			x.report(PolicyIssueNonNumericValue, element, "non-numeric value %q for %s operation", f.GetValue(), op)
+		}
+	case AND, OR:
+		if f.GetKey() != "" || f.GetValue() != "" {
+			x.report(PolicyIssueCompoundKeyValue, element, "key or value set for %s operation", op)
+		}
+
+		if len(sub) == 0 {
+			x.report(PolicyIssueEmptyCompound, element, "no sub-filters for %s operation", op)
+		}
+	}
+
+	if len(sub) != 0 {
+		switch op {
+		case EQ, NE, GT, GE, LT, LE:
+			x.report(PolicyIssueLeafSubFilters, element, "sub-filters are ignored by %s operation", op)
+		default:
+		}
+	}
+
+	for i := range sub {
+		x.checkFilter(&sub[i], fmt.Sprintf("%s: sub-filter #%d", element, i), false, order)
+	}
+
+	if op != UnspecifiedOperation && name != "" && name != MainFilterName {
+		*order++
+	}
+}
+
+// PlacementShortage describes the selector of the placement policy which can
+// not be satisfied by the network map.
+type PlacementShortage struct {
+	// Selector is a name of the selector.
+	Selector string
+
+	// Replica is an index of the replica without selector in the policy
+	// without selectors, such replicas select nodes on their own. -1 for the
+	// explicit selectors.
+	Replica int
+
+	// Required is a number of buckets for Distinct (or unspecified) clause and
+	// a number of nodes in the bucket for Same clause required by the
+	// selector.
+	Required int
+
+	// Available is a number of buckets or a number of nodes in the largest
+	// bucket (see Required) provided by the network map.
+	Available int
+}
+
+func (x PlacementShortage) String() string {
+	var what string
+	if x.Replica >= 0 {
+		what = "replica #" + strconv.Itoa(x.Replica)
+	} else {
+		what = fmt.Sprintf("selector %q", x.Selector)
+	}
+
+	return fmt.Sprintf("%s: %d required, %d available", what, x.Required, x.Available)
+}
+
+// PlacementShortages checks if the network map can satisfy the placement
+// policy and returns selectors falling short in the policy order, ContainerNodes
+// fails with ErrNotEnoughNodes for such policies. Backup nodes are not
+// required, see ContainerNodes for details. Returns an error if the policy
+// filters are invalid.
+func PlacementShortages(nm *NetMap, p *PlacementPolicy) ([]PlacementShortage, error) {
+	c, err := newPlacementContext(nm, p, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []PlacementShortage
+
+	check := func(s *Selector, replica int) error {
+		buckets, err := c.buckets(s)
+		if err != nil {
+			return err
+		}
+
+		sh := PlacementShortage{
+			Selector: s.GetName(),
+			Replica:  replica,
+			Required: int(s.GetCount()),
+		}
+
+		if s.GetClause() == Same {
+			for i := range buckets {
+				if n := len(buckets[i].nodes); n > sh.Available {
+					sh.Available = n
+				}
+			}
+		} else {
+			sh.Available = len(buckets)
+		}
+
+		if sh.Available < sh.Required {
+			res = append(res, sh)
+		}
+
+		return nil
+	}
+
+	selectors := p.GetSelectors()
+
+	for i := range selectors {
+		err = check(&selectors[i], -1)
+		if err != nil {
+			return nil, fmt.Errorf("selector %q: %w", selectors[i].GetName(), err)
+		}
+	}
+
+	if len(selectors) == 0 {
+		replicas := p.GetReplicas()
+
+		for i := range replicas {
+			var s Selector
+			s.SetCount(replicas[i].GetCount())
+			s.SetFilter(MainFilterName)
+
+			err = check(&s, i)
+			if err != nil {
+				return nil, fmt.Errorf("replica #%d: %w", i, err)
+			}
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,150 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func issueKinds(is []netmap.PolicyIssue) []netmap.PolicyIssueKind {
+	res := make([]netmap.PolicyIssueKind, len(is))
+	for i := range is {
+		res[i] = is[i].Kind
+	}
+
+	return res
+}
+
+func TestValidatePlacementPolicy(t *testing.T) {
+	p, err := netmap.ParsePlacementPolicy(`
+		REP 1 IN X
+		REP 2
+		SELECT 2 IN DISTINCT Country FROM F AS X
+		SELECT 1 FROM * AS Y
+		FILTER Price LE 10 AS Cheap
+		FILTER @Cheap AND (Country EQ RU OR Country EQ DE) AS F
+	`)
+	require.NoError(t, err)
+	require.Empty(t, netmap.ValidatePlacementPolicy(p))
+
+	for _, tc := range []struct {
+		name    string
+		policy  string
+		kinds   []netmap.PolicyIssueKind
+		element string
+	}{
+		{"zero replica count", "REP 0", []netmap.PolicyIssueKind{netmap.PolicyIssueZeroCount}, "replica #0"},
+		{"zero selector count", "REP 1 IN X SELECT 0 AS X", []netmap.PolicyIssueKind{netmap.PolicyIssueZeroCount}, "selector #0"},
+		{"undefined selector", "REP 1 IN Y SELECT 1 AS X", []netmap.PolicyIssueKind{netmap.PolicyIssueUndefinedSelector}, "replica #0"},
+		{"undefined filter", "REP 1 SELECT 1 FROM F", []netmap.PolicyIssueKind{netmap.PolicyIssueUndefinedFilter}, "selector #0"},
+		{"undefined nested filter", "REP 1 SELECT 1 FROM F FILTER A EQ B AND @G AS F",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueUndefinedFilter}, "filter #0: sub-filter #1"},
+		{"duplicate selector", "REP 1 SELECT 1 AS X SELECT 2 AS X", []netmap.PolicyIssueKind{netmap.PolicyIssueDuplicateName}, "selector #1"},
+		{"duplicate filter", "REP 1 SELECT 1 FROM F FILTER A EQ B AS F FILTER A EQ C AS F",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueDuplicateName}, "filter #1"},
+		{"reserved filter", `REP 1 FILTER A EQ B AS "*"`, []netmap.PolicyIssueKind{netmap.PolicyIssueInvalidName}, "filter #0"},
+		{"unused filter", "REP 1 SELECT 1 FROM F FILTER A EQ B AS F FILTER A EQ C AS G FILTER @G OR A EQ D AS H",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueUnusedFilter, netmap.PolicyIssueUnusedFilter}, "filter #1"},
+		{"forward reference", "REP 1 SELECT 1 FROM F FILTER A EQ B AND @G AS F FILTER A EQ C AS G",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueForwardReference}, "filter #0: sub-filter #1"},
+		{"self reference", "REP 1 SELECT 1 FROM F FILTER A EQ B AND @F AS F",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueFilterCycle}, "filter #0: sub-filter #1"},
+		{"cycle", "REP 1 SELECT 1 FROM F FILTER A EQ B AND @G AS F FILTER A EQ B OR @F AS G",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueFilterCycle}, "filter #0: sub-filter #1"},
+		{"non-numeric value", "REP 1 SELECT 1 FROM F FILTER A GT B AS F",
+			[]netmap.PolicyIssueKind{netmap.PolicyIssueNonNumericValue}, "filter #0"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := netmap.ParsePlacementPolicy(tc.policy)
+			require.NoError(t, err)
+
+			is := netmap.ValidatePlacementPolicy(p)
+			require.Equal(t, tc.kinds, issueKinds(is), is)
+			require.Equal(t, tc.element, is[0].Element)
+		})
+	}
+
+	t.Run("structure", func(t *testing.T) {
+		p := testPolicy(0, nil, []netmap.Selector{testSelector("", 1, 10, "", "F")},
+			testFilter("F", "A", netmap.OR, "B"),
+			testFilter("G", "", netmap.AND, ""),
+			testFilter("H", "A", netmap.EQ, "B", testFilter("", "", netmap.UnspecifiedOperation, "")),
+			testFilter("I", "", netmap.UnspecifiedOperation, ""),
+			testFilter("", "A", 100, "B"),
+		)
+
+		require.Equal(t, []netmap.PolicyIssueKind{
+			netmap.PolicyIssueNoReplicas,
+			netmap.PolicyIssueUnsupportedEnum,
+			netmap.PolicyIssueCompoundKeyValue,
+			netmap.PolicyIssueEmptyCompound,
+			netmap.PolicyIssueEmptyCompound,
+			netmap.PolicyIssueLeafSubFilters,
+			netmap.PolicyIssueMissingOperation,
+			netmap.PolicyIssueMissingOperation,
+			netmap.PolicyIssueInvalidName,
+			netmap.PolicyIssueUnsupportedEnum,
+			netmap.PolicyIssueUnusedFilter,
+			netmap.PolicyIssueUnusedFilter,
+		}, issueKinds(netmap.ValidatePlacementPolicy(p)))
+	})
+}
+
+func TestPlacementShortages(t *testing.T) {
+	nm := testNetMap()
+
+	p, err := netmap.ParsePlacementPolicy(`
+		REP 1 IN X
+		REP 1 IN Y
+		REP 1 IN Z
+		SELECT 3 IN DISTINCT Country AS X
+		SELECT 3 IN SAME Country FROM F AS Y
+		SELECT 2 IN Country FROM F AS Z
+		FILTER Country NE RU AS F
+	`)
+	require.NoError(t, err)
+
+	sh, err := netmap.PlacementShortages(nm, p)
+	require.NoError(t, err)
+	require.Empty(t, sh)
+
+	_, err = netmap.ContainerNodes(nm, p, nil)
+	require.NoError(t, err)
+
+	p, err = netmap.ParsePlacementPolicy(`
+		REP 1 IN X
+		REP 1 IN Y
+		REP 1 IN Z
+		SELECT 4 IN DISTINCT Country AS X
+		SELECT 4 IN SAME Country FROM F AS Y
+		SELECT 2 IN Country FROM F AS Z
+		FILTER Country NE RU AS F
+	`)
+	require.NoError(t, err)
+
+	sh, err = netmap.PlacementShortages(nm, p)
+	require.NoError(t, err)
+	require.Equal(t, []netmap.PlacementShortage{
+		{Selector: "X", Replica: -1, Required: 4, Available: 3},
+		{Selector: "Y", Replica: -1, Required: 4, Available: 3},
+	}, sh)
+	require.Equal(t, `selector "X": 4 required, 3 available`, sh[0].String())
+
+	_, err = netmap.ContainerNodes(nm, p, nil)
+	require.ErrorIs(t, err, netmap.ErrNotEnoughNodes)
+
+	p, err = netmap.ParsePlacementPolicy(`REP 5 REP 9`)
+	require.NoError(t, err)
+
+	sh, err = netmap.PlacementShortages(nm, p)
+	require.NoError(t, err)
+	require.Equal(t, []netmap.PlacementShortage{{Replica: 1, Required: 9, Available: 8}}, sh)
+	require.Equal(t, "replica #1: 9 required, 8 available", sh[0].String())
+
+	p, err = netmap.ParsePlacementPolicy(`REP 1 FILTER A GT B AS F`)
+	require.NoError(t, err)
+
+	_, err = netmap.PlacementShortages(nm, p)
+	require.Error(t, err)
+}
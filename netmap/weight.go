@@ -0,0 +1,70 @@
+package netmap
+
+import (
+	"strconv"
+)
+
+// NodeWeightFunc returns weight of the node in [0, 1] for weighted rendezvous
+// hashing. The greater the weight, the closer the node to any object.
+type NodeWeightFunc func(*NodeInfo) float64
+
+// CapacityPriceWeights returns NodeWeightFunc deriving node weights from the
+// Capacity and Price attributes relative to the given nodes the same way
+// NeoFS storage nodes do. The nodes must be all nodes of the network map.
+// Weight is a product of two factors in [0, 1]:
+//   - x/(1+x) where x is the capacity divided by the mean capacity of the
+//     nodes;
+//   - minimum price of the nodes divided by the price, zero price gives zero
+//     factor.
+//
+// Missing or non-numeric attributes are treated as zero.
+func CapacityPriceWeights(nodes []NodeInfo) NodeWeightFunc {
+	var (
+		meanCap  float64
+		minPrice float64
+	)
+
+	for i := range nodes {
+		c, _ := numericAttribute(&nodes[i], AttrCapacity)
+		p, _ := numericAttribute(&nodes[i], AttrPrice)
+
+		// incremental mean as storage nodes compute it, the result may
+		// differ from sum/count in the last bits
+		meanCap = meanCap*(float64(i)/float64(i+1)) + float64(c)/float64(i+1)
+
+		if i == 0 || float64(p) < minPrice {
+			minPrice = float64(p)
+		}
+	}
+
+	return func(n *NodeInfo) float64 {
+		c, _ := numericAttribute(n, AttrCapacity)
+		p, _ := numericAttribute(n, AttrPrice)
+
+		var capFactor, priceFactor float64
+
+		if meanCap != 0 {
+			x := float64(c) / meanCap
+			capFactor = x / (1 + x)
+		}
+
+		if p != 0 {
+			priceFactor = minPrice / float64(p)
+		}
+
+		return capFactor * priceFactor
+	}
+}
+
+func numericAttribute(n *NodeInfo, key string) (uint64, bool) {
+	attrs := n.GetAttributes()
+
+	for i := range attrs {
+		if attrs[i].GetKey() == key {
+			v, err := strconv.ParseUint(attrs[i].GetValue(), 10, 64)
+			return v, err == nil
+		}
+	}
+
+	return 0, false
+}
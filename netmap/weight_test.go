@@ -0,0 +1,32 @@
+package netmap_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCapacityPriceWeights(t *testing.T) {
+	nodes := []netmap.NodeInfo{
+		testNode(0, "Capacity", "10", "Price", "2"),
+		testNode(1, "Capacity", "30", "Price", "4"),
+		testNode(2, "Capacity", "20", "Price", "2"),
+		testNode(3, "Price", "2"),
+	}
+
+	// mean capacity is 15, min price is 2
+	w := netmap.CapacityPriceWeights(nodes)
+
+	for i, exp := range []float64{0.4, 2.0 / 3 / 2, 4.0 / 7, 0} {
+		require.InDelta(t, exp, w(&nodes[i]), 1e-9, i)
+	}
+
+	// zero min price
+	nodes = append(nodes, testNode(4, "Capacity", "many", "Price", "cheap"))
+	w = netmap.CapacityPriceWeights(nodes)
+
+	for i := range nodes {
+		require.Zero(t, w(&nodes[i]), i)
+	}
+}
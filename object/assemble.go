@@ -0,0 +1,253 @@
+package object
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// HeadFunc reads header of the object by its address.
+type HeadFunc func(refs.Address) (*Header, error)
+
+// GetFunc reads the object (header and payload) by its address.
+type GetFunc func(refs.Address) (*Object, error)
+
+var (
+	// ErrIncompleteSplitInfo is returned when SplitInfo has neither last part
+	// nor link object set, so the split chain can not be resolved.
+	ErrIncompleteSplitInfo = errors.New("neither last part nor link object is set")
+
+	// ErrPayloadMismatch is returned when restored payload does not correspond
+	// to the headers of the split hierarchy.
+	ErrPayloadMismatch = errors.New("payload mismatch")
+)
+
+// Assembler restores objects split into several child objects
+// (see Split) using the callbacks to access them.
+//
+// Assembler must be created via NewAssembler.
+type Assembler struct {
+	cnr refs.ContainerID
+
+	head HeadFunc
+
+	get GetFunc
+}
+
+// NewAssembler creates Assembler of the objects from the given container.
+// Both callbacks must not be nil.
+func NewAssembler(cnr refs.ContainerID, head HeadFunc, get GetFunc) *Assembler {
+	return &Assembler{
+		cnr:  cnr,
+		head: head,
+		get:  get,
+	}
+}
+
+func (x *Assembler) address(id *refs.ObjectID) refs.Address {
+	var addr refs.Address
+	addr.SetContainerID(&x.cnr)
+	addr.SetObjectID(id)
+
+	return addr
+}
+
+// ResolveChildren returns ordered list of the child objects referenced by
+// SplitInfo and the parent header. The link object is preferred if set.
+// Otherwise, split chain is walked backward from the last part via previous
+// object references. List elements are sized only if they were resolved from
+// the link object, zero otherwise.
+//
+// Returns ErrIncompleteSplitInfo if SplitInfo has neither link object nor last
+// part set.
+func (x *Assembler) ResolveChildren(info *SplitInfo) ([]MeasuredObject, *Header, error) {
+	if link := info.GetLink(); link != nil {
+		return x.resolveByLink(info, link)
+	}
+
+	if last := info.GetLastPart(); last != nil {
+		return x.resolveByChain(info, last)
+	}
+
+	return nil, nil, ErrIncompleteSplitInfo
+}
+
+func (x *Assembler) resolveByLink(info *SplitInfo, id *refs.ObjectID) ([]MeasuredObject, *Header, error) {
+	obj, err := x.get(x.address(id))
+	if err != nil {
+		return nil, nil, fmt.Errorf("get link object: %w", err)
+	}
+
+	hdr := obj.GetHeader()
+	if hdr.GetObjectType() != TypeLink {
+		return nil, nil, fmt.Errorf("link object has type %s instead of %s", hdr.GetObjectType(), TypeLink)
+	}
+
+	split := hdr.GetSplit()
+
+	err = checkSplitID(info, split)
+	if err != nil {
+		return nil, nil, fmt.Errorf("link object: %w", err)
+	}
+
+	err = checkPayload(hdr, obj.GetPayload())
+	if err != nil {
+		return nil, nil, fmt.Errorf("link object: %w", err)
+	}
+
+	var link Link
+
+	err = ReadLink(&link, *obj)
+	if err != nil {
+		return nil, nil, fmt.Errorf("link object: %w", err)
+	}
+
+	children := make([]MeasuredObject, 0, link.NumberOfChildren())
+	link.IterateChildren(func(child MeasuredObject) {
+		children = append(children, child)
+	})
+
+	return children, split.GetParentHeader(), nil
+}
+
+func (x *Assembler) resolveByChain(info *SplitInfo, id *refs.ObjectID) ([]MeasuredObject, *Header, error) {
+	var (
+		children []MeasuredObject
+		parent   *Header
+	)
+
+	for id != nil {
+		for i := range children {
+			if bytes.Equal(children[i].ID.GetValue(), id.GetValue()) {
+				return nil, nil, fmt.Errorf("cycle in split chain at %d object from the end", len(children))
+			}
+		}
+
+		hdr, err := x.head(x.address(id))
+		if err != nil {
+			return nil, nil, fmt.Errorf("head %d child object from the end: %w", len(children), err)
+		}
+
+		split := hdr.GetSplit()
+
+		err = checkSplitID(info, split)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%d child object from the end: %w", len(children), err)
+		}
+
+		if parent == nil {
+			parent = split.GetParentHeader()
+		}
+
+		children = append(children, MeasuredObject{ID: *id})
+		id = split.GetPrevious()
+	}
+
+	for i, j := 0, len(children)-1; i < j; i, j = i+1, j-1 {
+		children[i], children[j] = children[j], children[i]
+	}
+
+	return children, parent, nil
+}
+
+// Assemble resolves child objects referenced by SplitInfo (see
+// ResolveChildren) and writes their payloads in order to w. Each child
+// payload is checked against its header, while the total length (and SHA-256
+// checksum if any) of the restored payload is checked against the parent
+// header. Returns the parent header.
+//
+// Returns ErrPayloadMismatch if any check fails. Note that in this case some
+// of the payload may already be written to w.
+func (x *Assembler) Assemble(info *SplitInfo, w io.Writer) (*Header, error) {
+	children, parent, err := x.ResolveChildren(info)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent == nil {
+		return nil, errors.New("missing parent header in the split hierarchy")
+	}
+
+	var (
+		total   uint64
+		parHash = sha256.New()
+	)
+
+	for i := range children {
+		id := &children[i].ID
+
+		obj, err := x.get(x.address(id))
+		if err != nil {
+			return nil, fmt.Errorf("get child object #%d: %w", i, err)
+		}
+
+		payload := obj.GetPayload()
+
+		err = checkPayload(obj.GetHeader(), payload)
+		if err != nil {
+			return nil, fmt.Errorf("child object #%d: %w", i, err)
+		}
+
+		if sz := children[i].Size; sz != 0 && uint64(sz) != uint64(len(payload)) {
+			return nil, fmt.Errorf("%w: child object #%d has size %d, linked as %d",
+				ErrPayloadMismatch, i, len(payload), sz)
+		}
+
+		total += uint64(len(payload))
+		parHash.Write(payload)
+
+		_, err = w.Write(payload)
+		if err != nil {
+			return nil, fmt.Errorf("write payload of child object #%d: %w", i, err)
+		}
+	}
+
+	if exp := parent.GetPayloadLength(); total != exp {
+		return nil, fmt.Errorf("%w: restored %d bytes instead of %d", ErrPayloadMismatch, total, exp)
+	}
+
+	if cs := parent.GetPayloadHash(); cs.GetType() == refs.SHA256 && !bytes.Equal(cs.GetSum(), parHash.Sum(nil)) {
+		return nil, fmt.Errorf("%w: parent payload checksum", ErrPayloadMismatch)
+	}
+
+	return parent, nil
+}
+
+// checkSplitID checks that split ID from SplitInfo (if any) is the same as in
+// the split header.
+func checkSplitID(info *SplitInfo, split *SplitHeader) error {
+	if split == nil {
+		return errors.New("missing split header")
+	}
+
+	if exp := info.GetSplitID(); len(exp) != 0 && !bytes.Equal(exp, split.GetSplitID()) {
+		return errors.New("split ID mismatch")
+	}
+
+	return nil
+}
+
+// checkPayload checks payload length and SHA-256 checksum declared in the header.
+func checkPayload(hdr *Header, payload []byte) error {
+	if ln := hdr.GetPayloadLength(); ln != uint64(len(payload)) {
+		return fmt.Errorf("%w: length %d, declared %d", ErrPayloadMismatch, len(payload), ln)
+	}
+
+	cs := hdr.GetPayloadHash()
+
+	switch typ := cs.GetType(); typ {
+	default:
+		return fmt.Errorf("unsupported payload checksum type %s", typ)
+	case refs.SHA256:
+		sum := sha256.Sum256(payload)
+		if !bytes.Equal(sum[:], cs.GetSum()) {
+			return fmt.Errorf("%w: checksum", ErrPayloadMismatch)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	refstest "github.com/nspcc-dev/neofs-api-go/v2/refs/test"
+	"github.com/stretchr/testify/require"
+)
+
+type testStorage map[string]*object.Object
+
+func (x testStorage) get(addr refs.Address) (*object.Object, error) {
+	obj, ok := x[string(addr.GetObjectID().GetValue())]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+
+	return obj, nil
+}
+
+func (x testStorage) head(addr refs.Address) (*object.Header, error) {
+	obj, err := x.get(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return obj.GetHeader(), nil
+}
+
+func TestAssembler(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var hdr object.Header
+	hdr.SetContainerID(refstest.GenerateContainerID(false))
+
+	payload := make([]byte, 35)
+	_, _ = rand.Read(payload)
+
+	var (
+		storage = make(testStorage)
+		objs    []*object.Object
+	)
+
+	par, err := object.Split(key, hdr, 10, bytes.NewReader(payload), func(obj *object.Object) error {
+		obj.SetPayload(bytes.Clone(obj.GetPayload()))
+		storage[string(obj.GetObjectID().GetValue())] = obj
+		objs = append(objs, obj)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, objs, 5)
+
+	splitID := objs[0].GetHeader().GetSplit().GetSplitID()
+	a := object.NewAssembler(*hdr.GetContainerID(), storage.head, storage.get)
+
+	t.Run("incomplete", func(t *testing.T) {
+		_, err := a.Assemble(new(object.SplitInfo), new(bytes.Buffer))
+		require.ErrorIs(t, err, object.ErrIncompleteSplitInfo)
+	})
+
+	for _, tc := range []struct {
+		name string
+		info func(*object.SplitInfo)
+	}{
+		{name: "link", info: func(info *object.SplitInfo) { info.SetLink(objs[4].GetObjectID()) }},
+		{name: "last part", info: func(info *object.SplitInfo) { info.SetLastPart(objs[3].GetObjectID()) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			info := new(object.SplitInfo)
+			info.SetSplitID(splitID)
+			tc.info(info)
+
+			children, _, err := a.ResolveChildren(info)
+			require.NoError(t, err)
+			require.Len(t, children, 4)
+
+			for i := range children {
+				require.Equal(t, *objs[i].GetObjectID(), children[i].ID)
+			}
+
+			var buf bytes.Buffer
+
+			parHdr, err := a.Assemble(info, &buf)
+			require.NoError(t, err)
+			require.Equal(t, par.GetHeader(), parHdr)
+			require.Equal(t, payload, buf.Bytes())
+
+			info.SetSplitID([]byte{1, 2, 3})
+
+			_, err = a.Assemble(info, new(bytes.Buffer))
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("corrupted child", func(t *testing.T) {
+		info := new(object.SplitInfo)
+		info.SetLink(objs[4].GetObjectID())
+
+		objs[1].GetPayload()[0]++
+
+		_, err := a.Assemble(info, new(bytes.Buffer))
+		require.ErrorIs(t, err, object.ErrPayloadMismatch)
+	})
+}
@@ -0,0 +1,45 @@
+package object
+
+import (
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// eaclHeaderKeys lists object header fields available to eACL filters.
+var eaclHeaderKeys = [...]string{
+	acl.FilterObjectVersion,
+	acl.FilterObjectID,
+	acl.FilterObjectContainerID,
+	acl.FilterObjectOwnerID,
+	acl.FilterObjectCreationEpoch,
+	acl.FilterObjectPayloadLength,
+	acl.FilterObjectPayloadHash,
+	acl.FilterObjectType,
+	acl.FilterObjectHomomorphicHash,
+}
+
+// EACLHeaders returns the object header fields and attributes as headers of
+// acl.HeaderTypeObject for the eACL evaluation (see acl.Evaluate). Header
+// fields are keyed by the reserved keys from the acl package and encoded the
+// same way as for MatchSearchFilters, unset fields are omitted. Object ID is
+// optional.
+func EACLHeaders(id *refs.ObjectID, hdr *Header) []acl.Header {
+	attrs := hdr.GetAttributes()
+	res := make([]acl.Header, 0, len(eaclHeaderKeys)+len(attrs))
+
+	for _, key := range eaclHeaderKeys {
+		if val, ok := searchHeaderValue(key, id, hdr); ok {
+			var a Attribute
+			a.SetKey(key)
+			a.SetValue(val)
+
+			res = append(res, &a)
+		}
+	}
+
+	for i := range attrs {
+		res = append(res, &attrs[i])
+	}
+
+	return res
+}
@@ -0,0 +1,333 @@
+package object
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/lex"
+)
+
+const (
+	queryKeywordAnd     = "AND"
+	queryKeywordNot     = "NOT"
+	queryKeywordPresent = "PRESENT"
+	queryKeywordRoot    = "ROOT"
+	queryKeywordPhy     = "PHY"
+)
+
+var queryOperators = []struct {
+	op string
+	mt MatchType
+}{
+	{"==", MatchStringEqual},
+	{"!=", MatchStringNotEqual},
+	{"^=", MatchCommonPrefix},
+	{">=", MatchNumGE},
+	{"<=", MatchNumLE},
+	{">", MatchNumGT},
+	{"<", MatchNumLT},
+}
+
+// QuerySyntaxError describes search query syntax error.
+type QuerySyntaxError struct {
+	// Column is a 1-based position (in characters) of the error in the query.
+	Column int
+
+	// Message describes the error.
+	Message string
+}
+
+func (x *QuerySyntaxError) Error() string {
+	return fmt.Sprintf("syntax error at column %d: %s", x.Column, x.Message)
+}
+
+type queryTokenKind uint8
+
+const (
+	queryTokenEOF queryTokenKind = iota
+	queryTokenWord
+	queryTokenString
+	queryTokenOperator
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string // unquoted for strings
+	pos  int    // byte offset
+}
+
+type queryLexer struct {
+	src string
+	pos int
+}
+
+func isQueryBareChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+		strings.ContainsRune("_.:$-/", c)
+}
+
+func (x *queryLexer) errorf(pos int, format string, args ...any) error {
+	return &QuerySyntaxError{
+		Column:  utf8.RuneCountInString(x.src[:pos]) + 1,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func (x *queryLexer) next() (queryToken, error) {
+	for x.pos < len(x.src) && (x.src[x.pos] == ' ' || x.src[x.pos] == '\t' || x.src[x.pos] == '\n' || x.src[x.pos] == '\r') {
+		x.pos++
+	}
+
+	start := x.pos
+
+	if x.pos == len(x.src) {
+		return queryToken{kind: queryTokenEOF, pos: start}, nil
+	}
+
+	c, _ := utf8.DecodeRuneInString(x.src[x.pos:])
+
+	switch {
+	case c == '"':
+		s, n, err := lex.QuotedString(x.src[x.pos:])
+		if err != nil {
+			return queryToken{}, x.errorf(start, "%v", err)
+		}
+
+		x.pos += n
+
+		return queryToken{kind: queryTokenString, text: s, pos: start}, nil
+	case isQueryBareChar(c):
+		for x.pos < len(x.src) && isQueryBareChar(rune(x.src[x.pos])) {
+			x.pos++
+		}
+
+		return queryToken{kind: queryTokenWord, text: x.src[start:x.pos], pos: start}, nil
+	}
+
+	if i := lex.Operator(x.src[x.pos:], len(queryOperators), func(i int) string { return queryOperators[i].op }); i >= 0 {
+		x.pos += len(queryOperators[i].op)
+		return queryToken{kind: queryTokenOperator, text: queryOperators[i].op, pos: start}, nil
+	}
+
+	return queryToken{}, x.errorf(start, "unexpected character %q", c)
+}
+
+func (x queryToken) isKeyword(kw string) bool {
+	return x.kind == queryTokenWord && strings.EqualFold(x.text, kw)
+}
+
+func isQueryKeyword(s string) bool {
+	for _, kw := range [...]string{queryKeywordAnd, queryKeywordNot, queryKeywordPresent, queryKeywordRoot, queryKeywordPhy} {
+		if strings.EqualFold(s, kw) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func describeQueryToken(t queryToken) string {
+	switch t.kind {
+	default:
+		return fmt.Sprintf("%q", t.text)
+	case queryTokenEOF:
+		return "end of query"
+	case queryTokenString:
+		return "string " + strconv.Quote(t.text)
+	}
+}
+
+// ParseSearchQuery parses the search query into the list of search filters.
+//
+// Search query describes a list of search filters in a human-readable form.
+// Query is a sequence of conditions joined by AND keyword, each
+// condition is one of:
+//
+//	key == value     MatchStringEqual
+//	key != value     MatchStringNotEqual
+//	key ^= value     MatchCommonPrefix
+//	key > value      MatchNumGT
+//	key >= value     MatchNumGE
+//	key < value      MatchNumLT
+//	key <= value     MatchNumLE
+//	key NOT PRESENT  MatchNotPresent
+//	ROOT             FilterPropertyRoot
+//	PHY              FilterPropertyPhy
+//
+// Keys and values are either bare words of letters, digits and "_.:$-/"
+// characters or double-quoted Go string literals. Keywords are
+// case-insensitive, so keys and values matching them must be quoted.
+//
+// Example:
+//
+//	FileName == "cat.jpg" AND $Object:payloadLength > 1024 AND ROOT
+//
+// Empty (or blank) query results in an empty list. Returns *QuerySyntaxError
+// if the query is malformed.
+func ParseSearchQuery(s string) ([]SearchFilter, error) {
+	var (
+		lx  = queryLexer{src: s}
+		res []SearchFilter
+	)
+
+	tok, err := lx.next()
+	if err != nil {
+		return nil, err
+	}
+
+	if tok.kind == queryTokenEOF {
+		return nil, nil
+	}
+
+	for {
+		var f SearchFilter
+
+		switch {
+		case tok.isKeyword(queryKeywordRoot):
+			f.SetKey(FilterPropertyRoot)
+		case tok.isKeyword(queryKeywordPhy):
+			f.SetKey(FilterPropertyPhy)
+		case tok.kind == queryTokenString, tok.kind == queryTokenWord && !isQueryKeyword(tok.text):
+			f.SetKey(tok.text)
+
+			if tok, err = lx.next(); err != nil {
+				return nil, err
+			}
+
+			switch {
+			case tok.isKeyword(queryKeywordNot):
+				if tok, err = lx.next(); err != nil {
+					return nil, err
+				}
+
+				if !tok.isKeyword(queryKeywordPresent) {
+					return nil, lx.errorf(tok.pos, "expected %s, got %s", queryKeywordPresent, describeQueryToken(tok))
+				}
+
+				f.SetMatchType(MatchNotPresent)
+			case tok.kind == queryTokenOperator:
+				for i := range queryOperators {
+					if queryOperators[i].op == tok.text {
+						f.SetMatchType(queryOperators[i].mt)
+						break
+					}
+				}
+
+				if tok, err = lx.next(); err != nil {
+					return nil, err
+				}
+
+				if tok.kind != queryTokenString && (tok.kind != queryTokenWord || isQueryKeyword(tok.text)) {
+					return nil, lx.errorf(tok.pos, "expected value, got %s", describeQueryToken(tok))
+				}
+
+				f.SetValue(tok.text)
+			default:
+				return nil, lx.errorf(tok.pos, "expected operator or %s %s, got %s",
+					queryKeywordNot, queryKeywordPresent, describeQueryToken(tok))
+			}
+		default:
+			return nil, lx.errorf(tok.pos, "expected condition, got %s", describeQueryToken(tok))
+		}
+
+		res = append(res, f)
+
+		if tok, err = lx.next(); err != nil {
+			return nil, err
+		}
+
+		if tok.kind == queryTokenEOF {
+			return res, nil
+		}
+
+		if !tok.isKeyword(queryKeywordAnd) {
+			return nil, lx.errorf(tok.pos, "expected %s or end of query, got %s", queryKeywordAnd, describeQueryToken(tok))
+		}
+
+		if tok, err = lx.next(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// FormatSearchQuery formats the list of search filters into the search query
+// which can be parsed back by ParseSearchQuery. Values of the string matching
+// types are always quoted, while keys and numeric values are quoted only if
+// needed.
+//
+// Filters by FilterPropertyRoot and FilterPropertyPhy with MatchUnknown and
+// empty value are formatted as ROOT and PHY keywords respectively. Returns an
+// error if any other filter has unsupported matching type.
+func FormatSearchQuery(fs []SearchFilter) (string, error) {
+	var sb strings.Builder
+
+	for i := range fs {
+		if i > 0 {
+			sb.WriteString(" " + queryKeywordAnd + " ")
+		}
+
+		key, mt, val := fs[i].GetKey(), fs[i].GetMatchType(), fs[i].GetValue()
+
+		if mt == MatchUnknown && val == "" {
+			switch key {
+			case FilterPropertyRoot:
+				sb.WriteString(queryKeywordRoot)
+				continue
+			case FilterPropertyPhy:
+				sb.WriteString(queryKeywordPhy)
+				continue
+			}
+		}
+
+		if mt == MatchNotPresent {
+			if val != "" {
+				return "", fmt.Errorf("filter #%d: value is not supported by %s matching", i, mt)
+			}
+
+			sb.WriteString(quoteQueryWord(key) + " " + queryKeywordNot + " " + queryKeywordPresent)
+
+			continue
+		}
+
+		var op string
+
+		for j := range queryOperators {
+			if queryOperators[j].mt == mt {
+				op = queryOperators[j].op
+				break
+			}
+		}
+
+		if op == "" {
+			return "", fmt.Errorf("filter #%d: unsupported matching type %s", i, mt)
+		}
+
+		switch mt {
+		case MatchNumGT, MatchNumGE, MatchNumLT, MatchNumLE:
+			val = quoteQueryWord(val)
+		default:
+			val = strconv.Quote(val)
+		}
+
+		sb.WriteString(quoteQueryWord(key) + " " + op + " " + val)
+	}
+
+	return sb.String(), nil
+}
+
+// quoteQueryWord quotes s if it can not be written as a bare word.
+func quoteQueryWord(s string) string {
+	if s == "" || isQueryKeyword(s) {
+		return strconv.Quote(s)
+	}
+
+	for _, c := range s {
+		if !isQueryBareChar(c) {
+			return strconv.Quote(s)
+		}
+	}
+
+	return s
+}
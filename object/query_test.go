@@ -0,0 +1,94 @@
+package object_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSearchQuery(t *testing.T) {
+	fs, err := object.ParseSearchQuery(`Name == "cat.jpg" AND $Object:payloadLength > 1024 AND ROOT`)
+	require.NoError(t, err)
+	require.Equal(t, []object.SearchFilter{
+		searchFilter("Name", object.MatchStringEqual, "cat.jpg"),
+		searchFilter(object.FilterHeaderPayloadLength, object.MatchNumGT, "1024"),
+		searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""),
+	}, fs)
+
+	fs, err = object.ParseSearchQuery(" \t")
+	require.NoError(t, err)
+	require.Empty(t, fs)
+
+	fs, err = object.ParseSearchQuery(`"and" != and_ and Expires<=-1 AND Path ^= /home/ and phy AND "Name\t1" NOT PRESENT AND x>=0 AND y<2`)
+	require.NoError(t, err)
+	require.Equal(t, []object.SearchFilter{
+		searchFilter("and", object.MatchStringNotEqual, "and_"),
+		searchFilter("Expires", object.MatchNumLE, "-1"),
+		searchFilter("Path", object.MatchCommonPrefix, "/home/"),
+		searchFilter(object.FilterPropertyPhy, object.MatchUnknown, ""),
+		searchFilter("Name\t1", object.MatchNotPresent, ""),
+		searchFilter("x", object.MatchNumGE, "0"),
+		searchFilter("y", object.MatchNumLT, "2"),
+	}, fs)
+
+	for _, tc := range []struct {
+		query  string
+		column int
+	}{
+		{query: `AND`, column: 1},
+		{query: `Name`, column: 5},
+		{query: `Name = 1`, column: 6},
+		{query: `Name == `, column: 9},
+		{query: `Name == "cat`, column: 9},
+		{query: `Name == ROOT`, column: 9},
+		{query: `Name NOT ROOT`, column: 10},
+		{query: `Name == cat dog`, column: 13},
+		{query: `Name == cat AND`, column: 16},
+		{query: `Ключ == ok`, column: 1},
+		{query: `"Ключ" == ok AND`, column: 17},
+		{query: `Name == "\q"`, column: 9},
+	} {
+		_, err := object.ParseSearchQuery(tc.query)
+
+		var e *object.QuerySyntaxError
+		require.True(t, errors.As(err, &e), tc.query)
+		require.Equal(t, tc.column, e.Column, tc.query)
+	}
+}
+
+func TestFormatSearchQuery(t *testing.T) {
+	fs := []object.SearchFilter{
+		searchFilter("Name", object.MatchStringEqual, "cat.jpg"),
+		searchFilter(object.FilterHeaderPayloadLength, object.MatchNumGT, "1024"),
+		searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""),
+		searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue),
+		searchFilter("Not", object.MatchNotPresent, ""),
+		searchFilter("", object.MatchStringNotEqual, ""),
+		searchFilter("Quoted \"key\"", object.MatchCommonPrefix, "Ключ"),
+		searchFilter("Size", object.MatchNumLE, "1 000"),
+		searchFilter("Size", object.MatchNumGE, "-5"),
+		searchFilter("Size", object.MatchNumLT, "PHY"),
+	}
+
+	s, err := object.FormatSearchQuery(fs)
+	require.NoError(t, err)
+	require.Equal(t, `Name == "cat.jpg" AND $Object:payloadLength > 1024 AND ROOT AND `+
+		`$Object:PHY == "true" AND "Not" NOT PRESENT AND "" != "" AND "Quoted \"key\"" ^= "Ключ" AND `+
+		`Size <= "1 000" AND Size >= -5 AND Size < "PHY"`, s)
+
+	res, err := object.ParseSearchQuery(s)
+	require.NoError(t, err)
+	require.Equal(t, fs, res)
+
+	s, err = object.FormatSearchQuery(nil)
+	require.NoError(t, err)
+	require.Empty(t, s)
+
+	_, err = object.FormatSearchQuery([]object.SearchFilter{searchFilter("Name", object.MatchUnknown, "")})
+	require.Error(t, err)
+
+	_, err = object.FormatSearchQuery([]object.SearchFilter{searchFilter("Name", object.MatchNotPresent, "val")})
+	require.Error(t, err)
+}
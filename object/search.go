@@ -0,0 +1,162 @@
+package object
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/base58"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/decimal"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+// MatchSearchFilters checks whether the object with the given identifier and
+// header matches all the search filters the same way storage nodes process
+// SearchRequestBody. Empty filter list matches any object. Phy flag tells
+// whether the object is physically stored, it must be false for the parent
+// headers restored from the split chains.
+//
+// Reserved header keys (see ReservedFilterPrefix) are matched against the
+// text representations of the header fields: Base58 for the identifiers,
+// hex for the checksums, "vX.Y" for the version, base-10 integers for the
+// numbers, canonical UUID for the split ID and String() of Type for the object
+// type. Other keys are matched against the object attributes. Property
+// filters FilterPropertyRoot and FilterPropertyPhy match root (TypeRegular and
+// not a part of the split hierarchy) and physically stored objects
+// respectively regardless of the matching type and value.
+//
+// Numeric matching types (MatchNumGT, MatchNumGE, MatchNumLT, MatchNumLE)
+// compare arbitrary-precision base-10 integers, filter never matches if
+// either side is not an integer. Filters with MatchUnknown or unsupported
+// matching type never match. MatchNotPresent matches only if the field
+// or attribute is absent, MatchStringNotEqual matches absent ones too (as
+// "every object not having key=value"), other matching types require its
+// presence.
+func MatchSearchFilters(fs []SearchFilter, id *refs.ObjectID, hdr *Header, phy bool) bool {
+	for i := range fs {
+		if !matchSearchFilter(&fs[i], id, hdr, phy) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func matchSearchFilter(f *SearchFilter, id *refs.ObjectID, hdr *Header, phy bool) bool {
+	key := f.GetKey()
+
+	switch key {
+	case FilterPropertyRoot:
+		return hdr.GetObjectType() == TypeRegular && hdr.GetSplit() == nil
+	case FilterPropertyPhy:
+		return phy
+	}
+
+	val, ok := searchHeaderValue(key, id, hdr)
+
+	return matchValues(f.GetMatchType(), val, ok, f.GetValue())
+}
+
+func matchValues(mt MatchType, hdrVal string, present bool, filterVal string) bool {
+	switch {
+	case mt == MatchNotPresent:
+		return !present
+	case mt == MatchStringNotEqual:
+		return !present || hdrVal != filterVal
+	case !present:
+		return false
+	}
+
+	switch mt {
+	default:
+		return false
+	case MatchStringEqual:
+		return hdrVal == filterVal
+	case MatchCommonPrefix:
+		return strings.HasPrefix(hdrVal, filterVal)
+	case MatchNumGT, MatchNumGE, MatchNumLT, MatchNumLE:
+		c, ok := decimal.Compare(hdrVal, filterVal)
+		if !ok {
+			return false
+		}
+
+		switch mt {
+		case MatchNumGT:
+			return c > 0
+		case MatchNumGE:
+			return c >= 0
+		case MatchNumLT:
+			return c < 0
+		default:
+			return c <= 0
+		}
+	}
+}
+
+// searchHeaderValue returns text representation of the object header value
+// by search filter key and flag of its presence.
+func searchHeaderValue(key string, id *refs.ObjectID, hdr *Header) (string, bool) {
+	switch key {
+	default:
+		attrs := hdr.GetAttributes()
+		for i := range attrs {
+			if attrs[i].GetKey() == key {
+				return attrs[i].GetValue(), true
+			}
+		}
+
+		return "", false
+	case FilterHeaderVersion:
+		if v := hdr.GetVersion(); v != nil {
+			return "v" + strconv.FormatUint(uint64(v.GetMajor()), 10) +
+				"." + strconv.FormatUint(uint64(v.GetMinor()), 10), true
+		}
+	case FilterHeaderObjectID:
+		if id != nil {
+			return base58.Encode(id.GetValue()), true
+		}
+	case FilterHeaderContainerID:
+		if cid := hdr.GetContainerID(); cid != nil {
+			return base58.Encode(cid.GetValue()), true
+		}
+	case FilterHeaderOwnerID:
+		if owner := hdr.GetOwnerID(); owner != nil {
+			return base58.Encode(owner.GetValue()), true
+		}
+	case FilterHeaderCreationEpoch:
+		return strconv.FormatUint(hdr.GetCreationEpoch(), 10), true
+	case FilterHeaderPayloadLength:
+		return strconv.FormatUint(hdr.GetPayloadLength(), 10), true
+	case FilterHeaderObjectType:
+		return hdr.GetObjectType().String(), true
+	case FilterHeaderPayloadHash:
+		if cs := hdr.GetPayloadHash(); cs != nil {
+			return hex.EncodeToString(cs.GetSum()), true
+		}
+	case FilterHeaderHomomorphicHash:
+		if cs := hdr.GetHomomorphicHash(); cs != nil {
+			return hex.EncodeToString(cs.GetSum()), true
+		}
+	case FilterHeaderParent:
+		if par := hdr.GetSplit().GetParent(); par != nil {
+			return base58.Encode(par.GetValue()), true
+		}
+	case FilterHeaderSplitID:
+		if splitID := hdr.GetSplit().GetSplitID(); len(splitID) != 0 {
+			return formatSplitID(splitID), true
+		}
+	}
+
+	return "", false
+}
+
+// formatSplitID returns canonical UUID string of the split ID. IDs of
+// other length are hex-encoded.
+func formatSplitID(id []byte) string {
+	if len(id) != splitIDLen {
+		return hex.EncodeToString(id)
+	}
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[:4], id[4:6], id[6:8], id[8:10], id[10:])
+}
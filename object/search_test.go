@@ -0,0 +1,115 @@
+package object_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/stretchr/testify/require"
+)
+
+func searchFilter(key string, mt object.MatchType, val string) object.SearchFilter {
+	var f object.SearchFilter
+	f.SetKey(key)
+	f.SetMatchType(mt)
+	f.SetValue(val)
+
+	return f
+}
+
+func TestMatchSearchFilters(t *testing.T) {
+	var id refs.ObjectID
+	id.SetValue([]byte{0, 0, 0x28, 0x7f, 0xb4, 0xcd})
+
+	var ver refs.Version
+	ver.SetMajor(2)
+	ver.SetMinor(13)
+
+	var cs refs.Checksum
+	cs.SetType(refs.SHA256)
+	cs.SetSum([]byte{0xca, 0xfe})
+
+	attrs := make([]object.Attribute, 2)
+	attrs[0].SetKey("FileName")
+	attrs[0].SetValue("cat.jpg")
+	attrs[1].SetKey("Size")
+	attrs[1].SetValue("-10")
+
+	var hdr object.Header
+	hdr.SetVersion(&ver)
+	hdr.SetCreationEpoch(100)
+	hdr.SetPayloadLength(2048)
+	hdr.SetPayloadHash(&cs)
+	hdr.SetObjectType(object.TypeLock)
+	hdr.SetAttributes(attrs)
+
+	var split object.SplitHeader
+	split.SetParent(&id)
+	split.SetSplitID([]byte{0xdb, 0x8c, 0xe9, 0x2c, 0x8b, 0x26, 0x4a, 0xb2, 0x97, 0x15, 0x1e, 0x44, 0x45, 0x32, 0x96, 0xc8})
+
+	var child object.Header
+	child.SetSplit(&split)
+
+	var linkSplit object.SplitHeader
+	linkSplit.SetSplitID(split.GetSplitID())
+	linkSplit.SetChildren([]refs.ObjectID{id})
+
+	var link object.Header
+	link.SetSplit(&linkSplit)
+
+	var regular, tombstone, storageGroup object.Header
+	regular.SetAttributes(attrs)
+	tombstone.SetObjectType(object.TypeTombstone)
+	storageGroup.SetObjectType(object.TypeStorageGroup)
+
+	for _, tc := range []struct {
+		name  string
+		f     object.SearchFilter
+		hdr   *object.Header
+		phy   bool
+		match bool
+	}{
+		{"attribute equal", searchFilter("FileName", object.MatchStringEqual, "cat.jpg"), &hdr, true, true},
+		{"attribute not equal", searchFilter("FileName", object.MatchStringNotEqual, "cat.jpg"), &hdr, true, false},
+		{"attribute prefix", searchFilter("FileName", object.MatchCommonPrefix, "cat."), &hdr, true, true},
+		{"attribute wrong prefix", searchFilter("FileName", object.MatchCommonPrefix, "dog"), &hdr, true, false},
+		{"attribute absent", searchFilter("Name", object.MatchStringNotEqual, "cat.jpg"), &hdr, true, true},
+		{"attribute absent equal", searchFilter("Name", object.MatchStringEqual, ""), &hdr, true, false},
+		{"missing container not equal", searchFilter(object.FilterHeaderContainerID, object.MatchStringNotEqual, "11233QC4"), &hdr, true, true},
+		{"attribute not present", searchFilter("Name", object.MatchNotPresent, ""), &hdr, true, true},
+		{"attribute present", searchFilter("FileName", object.MatchNotPresent, ""), &hdr, true, false},
+		{"negative number", searchFilter("Size", object.MatchNumLT, "0"), &hdr, true, true},
+		{"non-numeric value", searchFilter("FileName", object.MatchNumGT, "0"), &hdr, true, false},
+		{"non-numeric filter", searchFilter("Size", object.MatchNumGT, "zero"), &hdr, true, false},
+		{"unknown match", searchFilter("FileName", object.MatchUnknown, "cat.jpg"), &hdr, true, false},
+		{"version", searchFilter(object.FilterHeaderVersion, object.MatchStringEqual, "v2.13"), &hdr, true, true},
+		{"object ID", searchFilter(object.FilterHeaderObjectID, object.MatchStringEqual, "11233QC4"), &hdr, true, true},
+		{"missing container", searchFilter(object.FilterHeaderContainerID, object.MatchNotPresent, ""), &hdr, true, true},
+		{"epoch GT", searchFilter(object.FilterHeaderCreationEpoch, object.MatchNumGT, "100"), &hdr, true, false},
+		{"epoch GE", searchFilter(object.FilterHeaderCreationEpoch, object.MatchNumGE, "100"), &hdr, true, true},
+		{"length LE", searchFilter(object.FilterHeaderPayloadLength, object.MatchNumLE, "2047"), &hdr, true, false},
+		{"type", searchFilter(object.FilterHeaderObjectType, object.MatchStringEqual, "LOCK"), &hdr, true, true},
+		{"payload hash", searchFilter(object.FilterHeaderPayloadHash, object.MatchStringEqual, "cafe"), &hdr, true, true},
+		{"root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &regular, false, true},
+		{"child root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &child, true, false},
+		{"link root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &link, true, false},
+		{"lock root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &hdr, true, false},
+		{"tombstone root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &tombstone, true, false},
+		{"storage group root", searchFilter(object.FilterPropertyRoot, object.MatchUnknown, ""), &storageGroup, true, false},
+		{"phy", searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue), &hdr, true, true},
+		{"virtual phy", searchFilter(object.FilterPropertyPhy, object.MatchStringEqual, object.BooleanPropertyValueTrue), &hdr, false, false},
+		{"parent", searchFilter(object.FilterHeaderParent, object.MatchStringEqual, "11233QC4"), &child, true, true},
+		{"no parent", searchFilter(object.FilterHeaderParent, object.MatchNotPresent, ""), &hdr, true, true},
+		{"split ID", searchFilter(object.FilterHeaderSplitID, object.MatchStringEqual, "db8ce92c-8b26-4ab2-9715-1e44453296c8"), &child, true, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.match, object.MatchSearchFilters([]object.SearchFilter{tc.f}, &id, tc.hdr, tc.phy))
+		})
+	}
+
+	require.True(t, object.MatchSearchFilters(nil, &id, &hdr, false))
+	require.False(t, object.MatchSearchFilters([]object.SearchFilter{
+		searchFilter("FileName", object.MatchStringEqual, "cat.jpg"),
+		searchFilter(object.FilterPropertyPhy, object.MatchUnknown, ""),
+	}, &id, &hdr, false))
+}
@@ -0,0 +1,310 @@
+package object
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"math"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/stable"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+// splitIDLen is a length of the split ID (UUID v4) in bytes.
+const splitIDLen = 16
+
+// minChunkBuffer is the initial capacity of the payload chunk buffers, they
+// grow up to the max object size as the payload is read.
+const minChunkBuffer = 4 << 10
+
+// ErrZeroMaxObjectSize is returned by Split when the maximum object size is zero.
+var ErrZeroMaxObjectSize = errors.New("zero max object size")
+
+// Split slices the payload read from r into objects of at most maxSize bytes
+// of payload each and passes them to f in the order they must be stored.
+// The key signs the identifiers of all produced objects.
+//
+// The parent header is used as a template: version, container, owner, creation
+// epoch and session token are inherited by all produced objects, while
+// attributes and object type are kept in the parent only. Payload length and
+// SHA-256 payload checksum of the parent are calculated from the read stream.
+// Homomorphic hashes are not calculated, the corresponding template field is
+// dropped.
+//
+// If payload fits into single object, f is called once with the parent object
+// carrying the whole payload. Otherwise, f is called for each child object
+// (so-called split chain) and then for the TypeLink object containing the Link
+// to all the children. Every child holds the random split ID, the previous
+// child (if any) and the first child (if it is not the first itself). The last
+// child and the Link object also carry the parent header, identifier and
+// signature.
+//
+// Payload slices passed to f are reused, so they are valid only until f
+// returns. Any error returned by f breaks the process and is returned as is.
+//
+// Payload buffers are allocated as the data is read, so memory usage is
+// bounded by the payload size rather than maxSize. Returns an error if maxSize
+// overflows int or uint32 (see MeasuredObject).
+//
+// Returns the parent object without payload on success.
+func Split(key *ecdsa.PrivateKey, parent Header, maxSize uint64, r io.Reader, f func(*Object) error) (*Object, error) {
+	if maxSize == 0 {
+		return nil, ErrZeroMaxObjectSize
+	}
+
+	if maxSize > math.MaxUint32 {
+		return nil, fmt.Errorf("max object size %d overflows uint32 size of the children", maxSize)
+	}
+
+	if maxSize > math.MaxInt {
+		return nil, fmt.Errorf("max object size %d overflows int", maxSize)
+	}
+
+	size := int(maxSize)
+
+	parent.homoHash = nil
+	parent.split = nil
+
+	var (
+		next    []byte
+		parHash = sha256.New()
+		parLen  uint64
+	)
+
+	cur, err := readChunk(r, nil, size)
+	if err != nil {
+		return nil, err
+	}
+
+	parHash.Write(cur)
+	parLen += uint64(len(cur))
+
+	if len(cur) == size {
+		next, err = readChunk(r, nil, size)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(next) == 0 {
+		return splitSingle(key, parent, cur, f)
+	}
+
+	splitID := make([]byte, splitIDLen)
+
+	_, err = rand.Read(splitID)
+	if err != nil {
+		return nil, fmt.Errorf("generate split ID: %w", err)
+	}
+
+	// UUID v4 layout: version and variant bits
+	splitID[6] = splitID[6]&0x0f | 0x40
+	splitID[8] = splitID[8]&0x3f | 0x80
+
+	var (
+		first, prev *refs.ObjectID
+		children    []MeasuredObject
+		parObj      *Object
+		child       *Object
+	)
+
+	for {
+		split := &SplitHeader{
+			first:   first,
+			prev:    prev,
+			splitID: splitID,
+		}
+
+		last := len(next) == 0
+		if last {
+			parObj, err = finalizeObject(key, parent, parHash, parLen)
+			if err != nil {
+				return nil, fmt.Errorf("finalize parent: %w", err)
+			}
+
+			split.par = parObj.objectID
+			split.parSig = parObj.idSig
+			split.parHdr = parObj.header
+		}
+
+		child, err = formChild(key, parent, TypeRegular, split, cur)
+		if err != nil {
+			return nil, fmt.Errorf("form child #%d: %w", len(children), err)
+		}
+
+		if err = f(child); err != nil {
+			return nil, err
+		}
+
+		if first == nil {
+			first = child.objectID
+		}
+
+		prev = child.objectID
+		children = append(children, MeasuredObject{
+			ID:   *child.objectID,
+			Size: uint32(len(cur)),
+		})
+
+		if last {
+			break
+		}
+
+		parHash.Write(next)
+		parLen += uint64(len(next))
+
+		cur, next = next, cur[:0]
+
+		if len(cur) == size {
+			next, err = readChunk(r, next, size)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	var link Link
+	link.SetChildren(children)
+
+	linkObj, err := formChild(key, parent, TypeLink, &SplitHeader{
+		par:     parObj.objectID,
+		parSig:  parObj.idSig,
+		parHdr:  parObj.header,
+		first:   first,
+		splitID: splitID,
+	}, link.StableMarshal(nil))
+	if err != nil {
+		return nil, fmt.Errorf("form link: %w", err)
+	}
+
+	if err = f(linkObj); err != nil {
+		return nil, err
+	}
+
+	return parObj, nil
+}
+
+// readChunk reads the next payload chunk of up to size bytes reusing buf
+// memory. The buffer grows as the data is read but never exceeds size bytes.
+// Returns less than size bytes only if r is exhausted.
+func readChunk(r io.Reader, buf []byte, size int) ([]byte, error) {
+	buf = buf[:0]
+
+	for len(buf) < size {
+		if len(buf) == cap(buf) {
+			newCap := 2 * cap(buf)
+			if newCap < minChunkBuffer {
+				newCap = minChunkBuffer
+			}
+
+			if newCap > size {
+				newCap = size
+			}
+
+			grown := make([]byte, len(buf), newCap)
+			copy(grown, buf)
+			buf = grown
+		}
+
+		end := cap(buf)
+		if end > size {
+			end = size
+		}
+
+		n, err := r.Read(buf[len(buf):end])
+		buf = buf[:len(buf)+n]
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("read payload: %w", err)
+		}
+	}
+
+	return buf, nil
+}
+
+func splitSingle(key *ecdsa.PrivateKey, parent Header, payload []byte, f func(*Object) error) (*Object, error) {
+	h := sha256.New()
+	h.Write(payload)
+
+	obj, err := finalizeObject(key, parent, h, uint64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+
+	obj.payload = payload
+
+	if err = f(obj); err != nil {
+		return nil, err
+	}
+
+	obj.payload = nil
+
+	return obj, nil
+}
+
+// formChild forms the object of the split hierarchy inheriting common fields
+// of the parent header.
+func formChild(key *ecdsa.PrivateKey, parent Header, typ Type, split *SplitHeader, payload []byte) (*Object, error) {
+	h := sha256.New()
+	h.Write(payload)
+
+	obj, err := finalizeObject(key, Header{
+		version:      parent.version,
+		cid:          parent.cid,
+		ownerID:      parent.ownerID,
+		creatEpoch:   parent.creatEpoch,
+		typ:          typ,
+		sessionToken: parent.sessionToken,
+		split:        split,
+	}, h, uint64(len(payload)))
+	if err != nil {
+		return nil, err
+	}
+
+	obj.payload = payload
+
+	return obj, nil
+}
+
+// finalizeObject sets payload length and checksum to the header, then
+// calculates and signs the object ID.
+func finalizeObject(key *ecdsa.PrivateKey, hdr Header, payloadHash hash.Hash, payloadLen uint64) (*Object, error) {
+	var cs refs.Checksum
+	cs.SetType(refs.SHA256)
+	cs.SetSum(payloadHash.Sum(nil))
+
+	hdr.payloadLen = payloadLen
+	hdr.payloadHash = &cs
+
+	obj := &Object{
+		header:   &hdr,
+		objectID: calculateID(&hdr),
+	}
+
+	err := signature.SignDataWithHandler(key, stable.Data{M: obj.objectID}, obj.SetSignature)
+	if err != nil {
+		return nil, fmt.Errorf("sign object ID: %w", err)
+	}
+
+	return obj, nil
+}
+
+// calculateID returns SHA-256 checksum of the object header in
+// Protocol Buffers binary format which is the object identifier.
+func calculateID(hdr *Header) *refs.ObjectID {
+	sum := sha256.Sum256(hdr.StableMarshal(nil))
+
+	var id refs.ObjectID
+	id.SetValue(sum[:])
+
+	return &id
+}
@@ -0,0 +1,196 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	refstest "github.com/nspcc-dev/neofs-api-go/v2/refs/test"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+	"github.com/stretchr/testify/require"
+)
+
+type objectIDData struct {
+	obj *object.Object
+}
+
+func (x objectIDData) ReadSignedData(buf []byte) ([]byte, error) {
+	return x.obj.GetObjectID().StableMarshal(buf), nil
+}
+
+func (x objectIDData) SignedDataSize() int {
+	return x.obj.GetObjectID().StableSize()
+}
+
+func requireValidObject(t *testing.T, obj *object.Object) {
+	hdr := obj.GetHeader()
+	id := sha256.Sum256(hdr.StableMarshal(nil))
+	require.Equal(t, id[:], obj.GetObjectID().GetValue())
+	require.NoError(t, signature.VerifyDataWithSource(objectIDData{obj}, obj.GetSignature))
+
+	if obj.GetPayload() != nil || hdr.GetPayloadLength() == 0 {
+		cs := sha256.Sum256(obj.GetPayload())
+		require.Equal(t, cs[:], hdr.GetPayloadHash().GetSum())
+		require.EqualValues(t, len(obj.GetPayload()), hdr.GetPayloadLength())
+	}
+}
+
+func splitPayload(t *testing.T, payload []byte, maxSize uint64) (*object.Object, []*object.Object, [][]byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var hdr object.Header
+	hdr.SetVersion(refstest.GenerateVersion(false))
+	hdr.SetContainerID(refstest.GenerateContainerID(false))
+	hdr.SetOwnerID(refstest.GenerateOwnerID(false))
+	hdr.SetCreationEpoch(13)
+
+	attr := new(object.Attribute)
+	attr.SetKey("FileName")
+	attr.SetValue("cat.jpg")
+	hdr.SetAttributes([]object.Attribute{*attr})
+
+	var (
+		objs     []*object.Object
+		payloads [][]byte
+	)
+
+	par, err := object.Split(key, hdr, maxSize, bytes.NewReader(payload), func(obj *object.Object) error {
+		requireValidObject(t, obj)
+
+		objs = append(objs, obj)
+		payloads = append(payloads, bytes.Clone(obj.GetPayload()))
+
+		return nil
+	})
+	require.NoError(t, err)
+
+	requireValidObject(t, par)
+	require.EqualValues(t, len(payload), par.GetHeader().GetPayloadLength())
+	cs := sha256.Sum256(payload)
+	require.Equal(t, cs[:], par.GetHeader().GetPayloadHash().GetSum())
+	require.Equal(t, hdr.GetAttributes(), par.GetHeader().GetAttributes())
+
+	return par, objs, payloads
+}
+
+func TestSplit(t *testing.T) {
+	_, err := object.Split(nil, object.Header{}, 0, bytes.NewReader(nil), nil)
+	require.ErrorIs(t, err, object.ErrZeroMaxObjectSize)
+
+	_, err = object.Split(nil, object.Header{}, math.MaxUint64, bytes.NewReader(nil), nil)
+	require.Error(t, err)
+
+	_, err = object.Split(nil, object.Header{}, math.MaxUint32+1, bytes.NewReader(nil), nil)
+	require.Error(t, err)
+
+	for _, tc := range []struct {
+		name          string
+		size, maxSize int
+	}{
+		{name: "empty", size: 0, maxSize: 10},
+		{name: "small", size: 5, maxSize: 10},
+		{name: "exact", size: 10, maxSize: 10},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			payload := make([]byte, tc.size)
+			_, _ = rand.Read(payload)
+
+			par, objs, payloads := splitPayload(t, payload, uint64(tc.maxSize))
+			require.Len(t, objs, 1)
+			require.Equal(t, par.GetObjectID(), objs[0].GetObjectID())
+			require.Nil(t, objs[0].GetHeader().GetSplit())
+			require.Equal(t, payload, payloads[0])
+		})
+	}
+
+	for _, tc := range []struct {
+		name          string
+		size, maxSize int
+		children      int
+	}{
+		{name: "two", size: 11, maxSize: 10, children: 2},
+		{name: "aligned", size: 30, maxSize: 10, children: 3},
+		{name: "tail", size: 31, maxSize: 10, children: 4},
+		{name: "growing buffers", size: 20000, maxSize: 9000, children: 3},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			payload := make([]byte, tc.size)
+			_, _ = rand.Read(payload)
+
+			par, objs, payloads := splitPayload(t, payload, uint64(tc.maxSize))
+			require.Len(t, objs, tc.children+1)
+
+			children, linkObj := objs[:tc.children], objs[tc.children]
+			splitID := children[0].GetHeader().GetSplit().GetSplitID()
+			require.Len(t, splitID, 16)
+
+			var joined []byte
+
+			for i := range children {
+				split := children[i].GetHeader().GetSplit()
+				require.Equal(t, splitID, split.GetSplitID())
+				require.Equal(t, object.TypeRegular, children[i].GetHeader().GetObjectType())
+				require.Empty(t, children[i].GetHeader().GetAttributes())
+
+				if i == 0 {
+					require.Nil(t, split.GetPrevious())
+					require.Nil(t, split.GetFirst())
+				} else {
+					require.Equal(t, children[i-1].GetObjectID(), split.GetPrevious())
+					require.Equal(t, children[0].GetObjectID(), split.GetFirst())
+				}
+
+				if i == len(children)-1 {
+					require.Equal(t, par.GetObjectID(), split.GetParent())
+					require.Equal(t, par.GetSignature(), split.GetParentSignature())
+					require.Equal(t, par.GetHeader(), split.GetParentHeader())
+				} else {
+					require.Nil(t, split.GetParent())
+					require.Nil(t, split.GetParentHeader())
+				}
+
+				joined = append(joined, payloads[i]...)
+			}
+
+			require.Equal(t, payload, joined)
+
+			require.Equal(t, object.TypeLink, linkObj.GetHeader().GetObjectType())
+			split := linkObj.GetHeader().GetSplit()
+			require.Equal(t, par.GetObjectID(), split.GetParent())
+			require.Equal(t, children[0].GetObjectID(), split.GetFirst())
+
+			var link object.Link
+			require.NoError(t, link.Unmarshal(payloads[tc.children]))
+			require.Equal(t, tc.children, link.NumberOfChildren())
+
+			var i int
+			link.IterateChildren(func(mo object.MeasuredObject) {
+				require.Equal(t, *children[i].GetObjectID(), mo.ID)
+				require.EqualValues(t, len(payloads[i]), mo.Size)
+				i++
+			})
+		})
+	}
+}
+
+func TestSplitMemory(t *testing.T) {
+	const maxSize = 64 << 20
+
+	payload := make([]byte, 5)
+	_, _ = rand.Read(payload)
+
+	var before, after runtime.MemStats
+
+	runtime.ReadMemStats(&before)
+	splitPayload(t, payload, maxSize)
+	runtime.ReadMemStats(&after)
+
+	require.Less(t, after.TotalAlloc-before.TotalAlloc, uint64(1<<20))
+}
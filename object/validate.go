@@ -0,0 +1,265 @@
+package object
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/storagegroup"
+	"github.com/nspcc-dev/neofs-api-go/v2/tombstone"
+)
+
+const (
+	containerIDLen = 32
+	ownerIDLen     = 25
+
+	sha256Len = 32
+	tzLen     = 64
+)
+
+// HeaderViolation describes object header field violating the protocol rules.
+type HeaderViolation struct {
+	// Path to the field in the JSON naming of NeoFS API V2 protocol,
+	// e.g. "split.parentHeader.ownerID" or "attributes[1].value".
+	Field string
+
+	// Reason is a human-readable description of the violation.
+	Reason string
+}
+
+func (x HeaderViolation) Error() string {
+	return x.Field + ": " + x.Reason
+}
+
+type headerViolations struct {
+	prefix string
+	list   []HeaderViolation
+}
+
+func (x *headerViolations) add(field, format string, args ...any) {
+	x.list = append(x.list, HeaderViolation{
+		Field:  x.prefix + field,
+		Reason: fmt.Sprintf(format, args...),
+	})
+}
+
+// ValidateHeader checks that object header is formed according to the NeoFS
+// API V2 protocol rules and returns all the violations found. Returns nil
+// if the header is correct.
+//
+// The following rules are checked:
+//   - version, container and owner are set and correctly sized;
+//   - payload checksums have known type and proper length;
+//   - objects of TypeTombstone, TypeLock, TypeLink and TypeStorageGroup
+//     have non-empty payload, TypeLink objects are parts of the split hierarchy;
+//   - attributes have unique non-empty keys and non-empty values;
+//   - values of SysAttributeExpEpoch and SysAttributeTickEpoch are base-10 integers;
+//   - split children refer to the parent or the previous child (the first
+//     child may be marked with the split ID only), parent signature is
+//     accompanied by the parent header which is correct itself.
+//
+// Payload itself is not checked, see ValidateObject.
+func ValidateHeader(h *Header) []HeaderViolation {
+	var v headerViolations
+
+	validateHeader(&v, h)
+
+	return v.list
+}
+
+// ValidateObject checks the object header like ValidateHeader and also
+// checks that payload corresponds to the header: its length and SHA-256
+// checksum match, and the payload of TypeTombstone, TypeLock, TypeLink and
+// TypeStorageGroup objects is a valid message of the corresponding type.
+// Payload-related violations are reported for the "payload" field.
+func ValidateObject(obj *Object) []HeaderViolation {
+	var v headerViolations
+
+	hdr := obj.GetHeader()
+	if hdr == nil {
+		v.add("header", "missing")
+		return v.list
+	}
+
+	validateHeader(&v, hdr)
+
+	payload := obj.GetPayload()
+
+	if ln := hdr.GetPayloadLength(); ln != uint64(len(payload)) {
+		v.add("payload", "length %d, declared %d", len(payload), ln)
+	} else if cs := hdr.GetPayloadHash(); cs.GetType() == refs.SHA256 && len(cs.GetSum()) == sha256Len {
+		if err := checkPayload(hdr, payload); err != nil {
+			v.add("payload", "%v", err)
+		}
+	}
+
+	if len(payload) == 0 {
+		return v.list
+	}
+
+	var err error
+
+	switch hdr.GetObjectType() {
+	case TypeTombstone:
+		var ts tombstone.Tombstone
+		if err = ts.Unmarshal(payload); err == nil && len(ts.GetMembers()) == 0 {
+			v.add("payload", "tombstone without members")
+		}
+	case TypeStorageGroup:
+		var sg storagegroup.StorageGroup
+		if err = sg.Unmarshal(payload); err == nil && len(sg.GetMembers()) == 0 {
+			v.add("payload", "storage group without members")
+		}
+	case TypeLock:
+		var l Lock
+		if err = l.Unmarshal(payload); err == nil && l.NumberOfMembers() == 0 {
+			v.add("payload", "lock without members")
+		}
+	case TypeLink:
+		var l Link
+		if err = l.Unmarshal(payload); err == nil && l.NumberOfChildren() == 0 {
+			v.add("payload", "link without children")
+		}
+	}
+
+	if err != nil {
+		v.add("payload", "invalid %s message: %v", hdr.GetObjectType(), err)
+	}
+
+	return v.list
+}
+
+func validateHeader(v *headerViolations, h *Header) {
+	if h.GetVersion() == nil {
+		v.add("version", "missing")
+	}
+
+	if cid := h.GetContainerID(); cid == nil {
+		v.add("containerID", "missing")
+	} else if ln := len(cid.GetValue()); ln != containerIDLen {
+		v.add("containerID", "invalid length %d, expected %d", ln, containerIDLen)
+	}
+
+	if owner := h.GetOwnerID(); owner == nil {
+		v.add("ownerID", "missing")
+	} else if ln := len(owner.GetValue()); ln != ownerIDLen {
+		v.add("ownerID", "invalid length %d, expected %d", ln, ownerIDLen)
+	}
+
+	if cs := h.GetPayloadHash(); cs == nil {
+		v.add("payloadHash", "missing")
+	} else {
+		validateChecksum(v, "payloadHash", cs)
+	}
+
+	if cs := h.GetHomomorphicHash(); cs != nil {
+		if typ := cs.GetType(); typ != refs.TillichZemor {
+			v.add("homomorphicHash.type", "unexpected type %s", typ)
+		} else {
+			validateChecksum(v, "homomorphicHash", cs)
+		}
+	}
+
+	switch typ := h.GetObjectType(); typ {
+	default:
+		v.add("objectType", "unknown type %d", typ)
+	case TypeRegular:
+	case TypeTombstone, TypeLock, TypeStorageGroup, TypeLink:
+		if h.GetPayloadLength() == 0 {
+			v.add("payloadLength", "empty payload of %s object", typ)
+		}
+
+		if typ == TypeLink && h.GetSplit() == nil {
+			v.add("split", "missing in %s object", typ)
+		}
+	}
+
+	validateAttributes(v, h.GetAttributes())
+
+	if split := h.GetSplit(); split != nil {
+		validateSplitHeader(v, h.GetObjectType(), split)
+	}
+}
+
+func validateChecksum(v *headerViolations, field string, cs *refs.Checksum) {
+	var expLen int
+
+	switch typ := cs.GetType(); typ {
+	default:
+		v.add(field+".type", "unknown type %d", typ)
+		return
+	case refs.SHA256:
+		expLen = sha256Len
+	case refs.TillichZemor:
+		expLen = tzLen
+	}
+
+	if ln := len(cs.GetSum()); ln != expLen {
+		v.add(field+".sum", "invalid length %d for %s, expected %d", ln, cs.GetType(), expLen)
+	}
+}
+
+func validateAttributes(v *headerViolations, attrs []Attribute) {
+	keys := make(map[string]int, len(attrs))
+
+	for i := range attrs {
+		key, val := attrs[i].GetKey(), attrs[i].GetValue()
+		field := "attributes[" + strconv.Itoa(i) + "]"
+
+		if key == "" {
+			v.add(field+".key", "empty")
+		} else if j, ok := keys[key]; ok {
+			v.add(field+".key", "duplicates key of attributes[%d]", j)
+		} else {
+			keys[key] = i
+		}
+
+		if val == "" {
+			v.add(field+".value", "empty")
+			continue
+		}
+
+		switch key {
+		case SysAttributeExpEpoch, SysAttributeTickEpoch:
+			if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+				v.add(field+".value", "invalid epoch of %s: %v", key, err)
+			}
+		}
+	}
+}
+
+func validateSplitHeader(v *headerViolations, typ Type, split *SplitHeader) {
+	par, prev := split.GetParent(), split.GetPrevious()
+
+	if par == nil && prev == nil && split.GetFirst() == nil &&
+		len(split.GetSplitID()) == 0 && len(split.GetChildren()) == 0 {
+		v.add("split", "child must have parent or previous object")
+	}
+
+	if typ == TypeRegular && split.GetFirst() != nil && prev == nil {
+		v.add("split.previous", "missing while first object is set")
+	}
+
+	if id := split.GetSplitID(); len(id) != 0 && len(id) != splitIDLen {
+		v.add("split.splitID", "invalid length %d, expected %d", len(id), splitIDLen)
+	}
+
+	parHdr := split.GetParentHeader()
+
+	if split.GetParentSignature() != nil && parHdr == nil {
+		v.add("split.parentHeader", "missing while parent signature is set")
+	}
+
+	if parHdr != nil {
+		if parHdr.GetSplit() != nil {
+			v.add("split.parentHeader.split", "parent must not be a child")
+		}
+
+		prefix := v.prefix
+		v.prefix += "split.parentHeader."
+
+		validateHeader(v, parHdr)
+
+		v.prefix = prefix
+	}
+}
@@ -0,0 +1,153 @@
+package object_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	refstest "github.com/nspcc-dev/neofs-api-go/v2/refs/test"
+	"github.com/stretchr/testify/require"
+)
+
+func validHeader() object.Header {
+	var cid refs.ContainerID
+	cid.SetValue(make([]byte, 32))
+
+	var owner refs.OwnerID
+	owner.SetValue(make([]byte, 25))
+
+	var cs refs.Checksum
+	cs.SetType(refs.SHA256)
+	cs.SetSum(make([]byte, 32))
+
+	var hdr object.Header
+	hdr.SetVersion(refstest.GenerateVersion(false))
+	hdr.SetContainerID(&cid)
+	hdr.SetOwnerID(&owner)
+	hdr.SetPayloadHash(&cs)
+
+	return hdr
+}
+
+func violatedFields(vs []object.HeaderViolation) []string {
+	res := make([]string, len(vs))
+	for i := range vs {
+		res[i] = vs[i].Field
+	}
+
+	return res
+}
+
+func TestValidateHeader(t *testing.T) {
+	hdr := validHeader()
+	require.Empty(t, object.ValidateHeader(&hdr))
+
+	t.Run("required", func(t *testing.T) {
+		require.Equal(t, []string{"version", "containerID", "ownerID", "payloadHash"},
+			violatedFields(object.ValidateHeader(new(object.Header))))
+	})
+
+	t.Run("checksum", func(t *testing.T) {
+		hdr := validHeader()
+
+		var cs refs.Checksum
+		cs.SetType(refs.TillichZemor)
+		cs.SetSum(make([]byte, 32))
+		hdr.SetPayloadHash(&cs)
+
+		var hcs refs.Checksum
+		hcs.SetType(refs.SHA256)
+		hdr.SetHomomorphicHash(&hcs)
+
+		require.Equal(t, []string{"payloadHash.sum", "homomorphicHash.type"},
+			violatedFields(object.ValidateHeader(&hdr)))
+	})
+
+	t.Run("type", func(t *testing.T) {
+		hdr := validHeader()
+		hdr.SetObjectType(object.TypeLink)
+
+		require.Equal(t, []string{"payloadLength", "split"}, violatedFields(object.ValidateHeader(&hdr)))
+
+		hdr.SetObjectType(100)
+		require.Equal(t, []string{"objectType"}, violatedFields(object.ValidateHeader(&hdr)))
+	})
+
+	t.Run("attributes", func(t *testing.T) {
+		hdr := validHeader()
+
+		attrs := make([]object.Attribute, 5)
+		attrs[0].SetKey("k")
+		attrs[0].SetValue("v")
+		attrs[1].SetKey("k")
+		attrs[1].SetValue("v")
+		attrs[2].SetValue("v")
+		attrs[3].SetKey("empty")
+		attrs[4].SetKey(object.SysAttributeExpEpoch)
+		attrs[4].SetValue("tomorrow")
+		hdr.SetAttributes(attrs)
+
+		require.Equal(t, []string{"attributes[1].key", "attributes[2].key", "attributes[3].value", "attributes[4].value"},
+			violatedFields(object.ValidateHeader(&hdr)))
+	})
+
+	t.Run("split", func(t *testing.T) {
+		hdr := validHeader()
+
+		split := new(object.SplitHeader)
+		split.SetFirst(refstest.GenerateObjectID(false))
+		split.SetSplitID([]byte{1})
+		split.SetParentSignature(new(refs.Signature))
+		hdr.SetSplit(split)
+
+		require.Equal(t, []string{"split.previous", "split.splitID", "split.parentHeader"},
+			violatedFields(object.ValidateHeader(&hdr)))
+
+		hdr.SetSplit(new(object.SplitHeader))
+		require.Equal(t, []string{"split"}, violatedFields(object.ValidateHeader(&hdr)))
+
+		split = new(object.SplitHeader)
+		split.SetParent(refstest.GenerateObjectID(false))
+		split.SetParentHeader(new(object.Header))
+		hdr.SetSplit(split)
+		require.Equal(t, []string{
+			"split.parentHeader.version",
+			"split.parentHeader.containerID",
+			"split.parentHeader.ownerID",
+			"split.parentHeader.payloadHash",
+		}, violatedFields(object.ValidateHeader(&hdr)))
+	})
+}
+
+func TestValidateObject(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	hdr := validHeader()
+	payload := make([]byte, 25)
+
+	var objs []*object.Object
+
+	_, err = object.Split(key, hdr, 10, bytes.NewReader(payload), func(obj *object.Object) error {
+		obj.SetPayload(bytes.Clone(obj.GetPayload()))
+		objs = append(objs, obj)
+		return nil
+	})
+	require.NoError(t, err)
+
+	for i := range objs {
+		require.Empty(t, object.ValidateObject(objs[i]), i)
+	}
+
+	objs[0].GetPayload()[0]++
+	require.Equal(t, []string{"payload"}, violatedFields(object.ValidateObject(objs[0])))
+
+	linkObj := objs[len(objs)-1]
+	linkObj.SetPayload([]byte{0xff})
+	linkObj.GetHeader().SetPayloadLength(1)
+	require.Equal(t, []string{"payload", "payload"}, violatedFields(object.ValidateObject(linkObj)))
+}
@@ -1,3 +1,25 @@
 package rpc
 
+import (
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/nspcc-dev/neofs-api-go/v2/status"
+)
+
 const serviceNamePrefix = "neo.fs.v2."
+
+// checkResponse verifies the response signatures and status.
+func checkResponse(resp any, meta *session.ResponseMetaHeader) error {
+	err := signature.VerifyServiceMessage(resp)
+	if err != nil {
+		return fmt.Errorf("verify response: %w", err)
+	}
+
+	if st := meta.GetStatus(); !status.IsSuccess(st.Code()) {
+		return fmt.Errorf("status %d: %s", st.Code(), st.Message())
+	}
+
+	return nil
+}
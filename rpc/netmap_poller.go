@@ -0,0 +1,209 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+)
+
+const defaultNetMapPollInterval = 10 * time.Second
+
+// NetMapPollerOption is a NetMapPoller's option.
+type NetMapPollerOption func(*NetMapPoller)
+
+// WithNetMapPollInterval returns option to specify interval between polls in
+// NetMapPoller.Run. Defaults to 10s.
+//
+// Ignored if not positive.
+func WithNetMapPollInterval(d time.Duration) NetMapPollerOption {
+	return func(p *NetMapPoller) {
+		if d > 0 {
+			p.interval = d
+		}
+	}
+}
+
+// WithNetMapPollErrorHandler returns option to specify handler of the poll
+// errors in NetMapPoller.Run. Errors are ignored by default.
+func WithNetMapPollErrorHandler(f func(error)) NetMapPollerOption {
+	return func(p *NetMapPoller) {
+		p.errHandler = f
+	}
+}
+
+// NetMapEvent describes the network map change on the epoch advance.
+type NetMapEvent struct {
+	// NetworkInfo is the network information at the new epoch.
+	NetworkInfo *netmap.NetworkInfo
+
+	// NetMap is the network map snapshot at the new epoch.
+	NetMap *netmap.NetMap
+
+	// Diff describes the changes relative to the previous snapshot. All
+	// nodes are added in the first event.
+	Diff netmap.NetMapDiff
+}
+
+// NetMapPoller periodically requests the network information and the network
+// map snapshot from the NeoFS node and reports the changes when the epoch
+// advances.
+//
+// NetMapPoller is not safe for concurrent use. Instances must be created
+// using NewNetMapPoller.
+type NetMapPoller struct {
+	cli *client.Client
+	key *ecdsa.PrivateKey
+
+	interval   time.Duration
+	errHandler func(error)
+
+	netInfo  func(*client.Client, *netmap.NetworkInfoRequest, ...client.CallOption) (*netmap.NetworkInfoResponse, error)
+	snapshot func(*client.Client, *netmap.SnapshotRequest, ...client.CallOption) (*netmap.SnapshotResponse, error)
+
+	epoch uint64
+	last  *netmap.NetMap
+}
+
+// NewNetMapPoller creates, configures via options and returns new
+// NetMapPoller instance polling the node via the client. Requests are signed
+// with the given key.
+func NewNetMapPoller(cli *client.Client, key *ecdsa.PrivateKey, opts ...NetMapPollerOption) *NetMapPoller {
+	p := &NetMapPoller{
+		cli:        cli,
+		key:        key,
+		interval:   defaultNetMapPollInterval,
+		errHandler: func(error) {},
+		netInfo:    NetworkInfo,
+		snapshot:   NetMapSnapshot,
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
+}
+
+// Poll requests the network information and, if the epoch is advanced since
+// the last successful poll (or it is the first one), the network map snapshot.
+// Returns nil event if the epoch is not advanced.
+func (p *NetMapPoller) Poll(ctx context.Context) (*NetMapEvent, error) {
+	var infoReq netmap.NetworkInfoRequest
+	infoReq.SetBody(new(netmap.NetworkInfoRequestBody))
+
+	err := p.sign(&infoReq)
+	if err != nil {
+		return nil, err
+	}
+
+	infoResp, err := p.netInfo(p.cli, &infoReq, client.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("network info: %w", err)
+	}
+
+	err = checkResponse(infoResp, infoResp.GetMetaHeader())
+	if err != nil {
+		return nil, fmt.Errorf("network info: %w", err)
+	}
+
+	info := infoResp.GetBody().GetNetworkInfo()
+	if info == nil {
+		return nil, errors.New("network info: missing network info")
+	}
+
+	epoch := info.GetCurrentEpoch()
+	if p.last != nil && epoch <= p.epoch {
+		return nil, nil
+	}
+
+	var snapshotReq netmap.SnapshotRequest
+	snapshotReq.SetBody(new(netmap.SnapshotRequestBody))
+
+	err = p.sign(&snapshotReq)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotResp, err := p.snapshot(p.cli, &snapshotReq, client.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("network map snapshot: %w", err)
+	}
+
+	err = checkResponse(snapshotResp, snapshotResp.GetMetaHeader())
+	if err != nil {
+		return nil, fmt.Errorf("network map snapshot: %w", err)
+	}
+
+	nm := snapshotResp.GetBody().NetMap()
+	if nm == nil {
+		return nil, errors.New("network map snapshot: missing network map")
+	}
+
+	ev := &NetMapEvent{
+		NetworkInfo: info,
+		NetMap:      nm,
+		Diff:        netmap.DiffNetMaps(p.last, nm),
+	}
+
+	// epoch may advance between the requests, so the snapshot one is used
+	p.epoch, p.last = nm.Epoch(), nm
+
+	return ev, nil
+}
+
+// Run polls the node (see Poll) immediately and then periodically and sends
+// events to the channel until the context is done. Poll errors are passed to
+// the error handler (see WithNetMapPollErrorHandler). Always returns the
+// context error.
+func (p *NetMapPoller) Run(ctx context.Context, ch chan<- NetMapEvent) error {
+	t := time.NewTicker(p.interval)
+	defer t.Stop()
+
+	for {
+		ev, err := p.Poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			p.errHandler(err)
+		} else if ev != nil {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case ch <- *ev:
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+type signedRequest interface {
+	SetMetaHeader(*session.RequestMetaHeader)
+}
+
+func (p *NetMapPoller) sign(req signedRequest) error {
+	var meta session.RequestMetaHeader
+	meta.SetTTL(1)
+
+	req.SetMetaHeader(&meta)
+
+	err := signature.SignServiceMessage(p.key, req)
+	if err != nil {
+		return fmt.Errorf("sign request: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,213 @@
+package rpc
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/netmap"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	"github.com/stretchr/testify/require"
+)
+
+// testNetmapServer imitates netmap service of the NeoFS node.
+type testNetmapServer struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+
+	mtx   sync.Mutex
+	epoch uint64
+	nodes []netmap.NodeInfo
+	err   error
+
+	snapshots int
+}
+
+func (x *testNetmapServer) set(epoch uint64, nodes ...netmap.NodeInfo) {
+	x.mtx.Lock()
+	x.epoch, x.nodes = epoch, nodes
+	x.mtx.Unlock()
+}
+
+func (x *testNetmapServer) netInfo(_ *client.Client, req *netmap.NetworkInfoRequest, _ ...client.CallOption) (*netmap.NetworkInfoResponse, error) {
+	require.NoError(x.t, signature.VerifyServiceMessage(req))
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	if x.err != nil {
+		return nil, x.err
+	}
+
+	var info netmap.NetworkInfo
+	info.SetCurrentEpoch(x.epoch)
+
+	var body netmap.NetworkInfoResponseBody
+	body.SetNetworkInfo(&info)
+
+	var resp netmap.NetworkInfoResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(new(session.ResponseMetaHeader))
+
+	return &resp, signature.SignServiceMessage(x.key, &resp)
+}
+
+func (x *testNetmapServer) snapshot(_ *client.Client, req *netmap.SnapshotRequest, _ ...client.CallOption) (*netmap.SnapshotResponse, error) {
+	require.NoError(x.t, signature.VerifyServiceMessage(req))
+
+	x.mtx.Lock()
+	defer x.mtx.Unlock()
+
+	x.snapshots++
+
+	var nm netmap.NetMap
+	nm.SetEpoch(x.epoch)
+	nm.SetNodes(x.nodes)
+
+	var body netmap.SnapshotResponseBody
+	body.SetNetMap(&nm)
+
+	var resp netmap.SnapshotResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(new(session.ResponseMetaHeader))
+
+	return &resp, signature.SignServiceMessage(x.key, &resp)
+}
+
+func testNetmapNode(key byte, state netmap.NodeState) netmap.NodeInfo {
+	var n netmap.NodeInfo
+	n.SetPublicKey([]byte{key})
+	n.SetState(state)
+
+	return n
+}
+
+func TestNetMapPoller_Poll(t *testing.T) {
+	nodeKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	srv := &testNetmapServer{t: t, key: nodeKey}
+	srv.set(5, testNetmapNode(1, netmap.Online), testNetmapNode(2, netmap.Online))
+
+	p := NewNetMapPoller(nil, key)
+	p.netInfo, p.snapshot = srv.netInfo, srv.snapshot
+
+	ev, err := p.Poll(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, ev)
+	require.EqualValues(t, 5, ev.NetworkInfo.GetCurrentEpoch())
+	require.Len(t, ev.Diff.Added, 2)
+	require.Equal(t, 1, srv.snapshots)
+
+	// same epoch
+	ev, err = p.Poll(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, ev)
+	require.Equal(t, 1, srv.snapshots)
+
+	srv.set(6, testNetmapNode(2, netmap.Offline), testNetmapNode(3, netmap.Online))
+
+	ev, err = p.Poll(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, ev)
+	require.EqualValues(t, 5, ev.Diff.OldEpoch)
+	require.EqualValues(t, 6, ev.Diff.NewEpoch)
+	require.Equal(t, []netmap.NodeInfo{testNetmapNode(3, netmap.Online)}, ev.Diff.Added)
+	require.Equal(t, []netmap.NodeInfo{testNetmapNode(1, netmap.Online)}, ev.Diff.Removed)
+	require.Len(t, ev.Diff.Changed, 1)
+	require.Equal(t, netmap.Offline, ev.Diff.Changed[0].NewState)
+	require.Equal(t, ev.NetMap.Nodes(), srv.nodes)
+
+	// epoch changes between the requests
+	p.netInfo = func(cli *client.Client, req *netmap.NetworkInfoRequest, opts ...client.CallOption) (*netmap.NetworkInfoResponse, error) {
+		resp, err := srv.netInfo(cli, req, opts...)
+		srv.set(8, testNetmapNode(3, netmap.Online))
+		return resp, err
+	}
+
+	srv.set(7)
+
+	ev, err = p.Poll(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, ev)
+	require.EqualValues(t, 7, ev.NetworkInfo.GetCurrentEpoch())
+	require.EqualValues(t, 6, ev.Diff.OldEpoch)
+	require.EqualValues(t, 8, ev.Diff.NewEpoch)
+
+	p.netInfo = srv.netInfo
+
+	ev, err = p.Poll(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, ev)
+
+	// invalid response signature
+	p.snapshot = func(cli *client.Client, req *netmap.SnapshotRequest, opts ...client.CallOption) (*netmap.SnapshotResponse, error) {
+		resp, err := srv.snapshot(cli, req, opts...)
+		resp.GetBody().NetMap().SetEpoch(100)
+		return resp, err
+	}
+
+	srv.set(9)
+
+	_, err = p.Poll(context.Background())
+	require.Error(t, err)
+}
+
+func TestNetMapPoller_Run(t *testing.T) {
+	nodeKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	srv := &testNetmapServer{t: t, key: nodeKey, err: errors.New("unavailable")}
+
+	errs := make(chan error, 10)
+
+	p := NewNetMapPoller(nil, key,
+		WithNetMapPollInterval(time.Millisecond),
+		WithNetMapPollErrorHandler(func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		}),
+	)
+	p.netInfo, p.snapshot = srv.netInfo, srv.snapshot
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan NetMapEvent)
+	done := make(chan error)
+
+	go func() { done <- p.Run(ctx, ch) }()
+
+	require.ErrorContains(t, <-errs, "unavailable")
+
+	srv.mtx.Lock()
+	srv.err = nil
+	srv.mtx.Unlock()
+
+	srv.set(1, testNetmapNode(1, netmap.Online))
+
+	ev := <-ch
+	require.EqualValues(t, 1, ev.NetworkInfo.GetCurrentEpoch())
+
+	srv.set(2, testNetmapNode(1, netmap.Maintenance))
+
+	ev = <-ch
+	require.EqualValues(t, 2, ev.NetworkInfo.GetCurrentEpoch())
+	require.Len(t, ev.Diff.Changed, 1)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+}
@@ -0,0 +1,265 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	utilsig "github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+const (
+	defaultSessionLifetime = 100
+	defaultSessionRenewal  = 1
+)
+
+// SessionManagerOption is a SessionManager's option.
+type SessionManagerOption func(*SessionManager)
+
+// WithSessionLifetime returns option to specify number of epochs the created
+// sessions live for. Defaults to 100.
+//
+// Ignored if zero.
+func WithSessionLifetime(epochs uint64) SessionManagerOption {
+	return func(m *SessionManager) {
+		if epochs != 0 {
+			m.lifetime = epochs
+		}
+	}
+}
+
+// WithSessionRenewal returns option to specify number of epochs before the
+// session expiration when it is renewed. Defaults to 1, i.e. the session is
+// renewed one epoch before the expiration. Must be less than the session
+// lifetime.
+func WithSessionRenewal(epochs uint64) SessionManagerOption {
+	return func(m *SessionManager) {
+		m.renewal = epochs
+	}
+}
+
+// SessionManager creates sessions with the NeoFS nodes via CreateSession and
+// issues session tokens for the particular operations within them. Sessions
+// are cached per (endpoint, owner) pair and renewed when they are close to
+// the expiration. Expired sessions are dropped from the cache.
+//
+// SessionManager is safe for concurrent use. Instances must be created using
+// NewSessionManager.
+type SessionManager struct {
+	lifetime, renewal uint64
+
+	create func(*client.Client, *session.CreateRequest) (*session.CreateResponse, error)
+
+	mtx      sync.Mutex
+	sessions map[sessionCacheKey]*cachedSession
+}
+
+type sessionCacheKey struct {
+	endpoint string
+	owner    string
+}
+
+type sessionInfo struct {
+	id, key []byte
+	exp     uint64
+}
+
+type cachedSession struct {
+	// serializes session renewal
+	mtx sync.Mutex
+
+	info sessionInfo
+
+	// expiration epoch of the created session, protected by
+	// SessionManager.mtx, zero until the session is created
+	exp uint64
+}
+
+// SessionTarget groups parameters of the session with the NeoFS node.
+type SessionTarget struct {
+	// Endpoint is the network address of the node, the client is
+	// connected to. Required.
+	Endpoint string
+
+	// Client of the node. Required.
+	Client *client.Client
+
+	// Key is the private key of the session owner. Required.
+	Key *ecdsa.PrivateKey
+
+	// CurrentEpoch is the current NeoFS epoch.
+	CurrentEpoch uint64
+}
+
+// NewSessionManager creates, configures via options and returns new
+// SessionManager instance. Returns an error if the session renewal is not
+// less than the session lifetime: such sessions would be created on every
+// call.
+func NewSessionManager(opts ...SessionManagerOption) (*SessionManager, error) {
+	m := &SessionManager{
+		lifetime: defaultSessionLifetime,
+		renewal:  defaultSessionRenewal,
+		create: func(cli *client.Client, req *session.CreateRequest) (*session.CreateResponse, error) {
+			return CreateSession(cli, req)
+		},
+		sessions: make(map[sessionCacheKey]*cachedSession),
+	}
+
+	for _, opt := range opts {
+		opt(m)
+	}
+
+	if m.renewal >= m.lifetime {
+		return nil, fmt.Errorf("session renewal %d is not less than session lifetime %d", m.renewal, m.lifetime)
+	}
+
+	return m, nil
+}
+
+// ObjectToken returns the session token for the object operation with the
+// given verb and target (container and, optionally, particular objects). The
+// token is valid until the session expiration and signed by the target key.
+func (m *SessionManager) ObjectToken(t SessionTarget, verb session.ObjectSessionVerb, cnr *refs.ContainerID, objs ...refs.ObjectID) (*session.Token, error) {
+	var c session.ObjectSessionContext
+	c.SetVerb(verb)
+	c.SetTarget(cnr, objs...)
+
+	return m.token(t, &c)
+}
+
+// ContainerToken returns the session token for the container operation with
+// the given verb. The token is for all containers of the owner if cnr is nil.
+// The token is valid until the session expiration and signed by the target
+// key.
+func (m *SessionManager) ContainerToken(t SessionTarget, verb session.ContainerSessionVerb, cnr *refs.ContainerID) (*session.Token, error) {
+	var c session.ContainerSessionContext
+	c.SetVerb(verb)
+	c.SetWildcard(cnr == nil)
+	c.SetContainerID(cnr)
+
+	return m.token(t, &c)
+}
+
+func (m *SessionManager) token(t SessionTarget, c session.TokenContext) (*session.Token, error) {
+	if t.Key == nil {
+		return nil, errors.New("empty private key")
+	}
+
+	var owner refs.OwnerID
+	owner.SetValue(ownerid.FromPublicKey(elliptic.MarshalCompressed(t.Key.Curve, t.Key.X, t.Key.Y)))
+
+	s, err := m.session(t, &owner)
+	if err != nil {
+		return nil, err
+	}
+
+	var lt session.TokenLifetime
+	lt.SetIat(t.CurrentEpoch)
+	lt.SetNbf(t.CurrentEpoch)
+	lt.SetExp(s.exp)
+
+	var body session.TokenBody
+	body.SetID(s.id)
+	body.SetOwnerID(&owner)
+	body.SetLifetime(&lt)
+	body.SetSessionKey(s.key)
+	body.SetContext(c)
+
+	var res session.Token
+	res.SetBody(&body)
+
+	err = utilsig.SignDataWithHandler(t.Key, &signature.StableMarshalerWrapper{SM: &body}, res.SetSignature)
+	if err != nil {
+		return nil, fmt.Errorf("sign session token: %w", err)
+	}
+
+	return &res, nil
+}
+
+// session returns cached session or creates a new one if there is no cached
+// session or it should be renewed.
+func (m *SessionManager) session(t SessionTarget, owner *refs.OwnerID) (sessionInfo, error) {
+	key := sessionCacheKey{
+		endpoint: t.Endpoint,
+		owner:    string(owner.GetValue()),
+	}
+
+	m.mtx.Lock()
+
+	for k, cached := range m.sessions {
+		if cached.exp != 0 && cached.exp < t.CurrentEpoch {
+			delete(m.sessions, k)
+		}
+	}
+
+	s, ok := m.sessions[key]
+	if !ok {
+		s = new(cachedSession)
+		m.sessions[key] = s
+	}
+
+	m.mtx.Unlock()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.info.id != nil && t.CurrentEpoch+m.renewal < s.info.exp {
+		return s.info, nil
+	}
+
+	exp := t.CurrentEpoch + m.lifetime
+
+	var body session.CreateRequestBody
+	body.SetOwnerID(owner)
+	body.SetExpiration(exp)
+
+	var meta session.RequestMetaHeader
+	meta.SetTTL(1)
+	meta.SetEpoch(t.CurrentEpoch)
+
+	var req session.CreateRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	err := signature.SignServiceMessage(t.Key, &req)
+	if err != nil {
+		return sessionInfo{}, fmt.Errorf("sign session request: %w", err)
+	}
+
+	resp, err := m.create(t.Client, &req)
+	if err != nil {
+		return sessionInfo{}, fmt.Errorf("create session: %w", err)
+	}
+
+	err = checkResponse(resp, resp.GetMetaHeader())
+	if err != nil {
+		return sessionInfo{}, fmt.Errorf("create session: %w", err)
+	}
+
+	if len(resp.GetBody().GetID()) == 0 {
+		return sessionInfo{}, errors.New("create session: empty session ID")
+	}
+
+	if len(resp.GetBody().GetSessionKey()) == 0 {
+		return sessionInfo{}, errors.New("create session: empty session key")
+	}
+
+	s.info = sessionInfo{
+		id:  resp.GetBody().GetID(),
+		key: resp.GetBody().GetSessionKey(),
+		exp: exp,
+	}
+
+	m.mtx.Lock()
+	s.exp = exp
+	m.mtx.Unlock()
+
+	return s.info, nil
+}
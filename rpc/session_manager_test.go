@@ -0,0 +1,167 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/binary"
+	"sync"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/rpc/client"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/signature"
+	utilsig "github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+	"github.com/stretchr/testify/require"
+)
+
+// testSessionServer imitates session service of the NeoFS node.
+type testSessionServer struct {
+	t   *testing.T
+	key *ecdsa.PrivateKey
+
+	mtx sync.Mutex
+	n   uint32
+}
+
+func (x *testSessionServer) create(_ *client.Client, req *session.CreateRequest) (*session.CreateResponse, error) {
+	require.NoError(x.t, signature.VerifyServiceMessage(req))
+
+	x.mtx.Lock()
+	x.n++
+	id := binary.BigEndian.AppendUint32(nil, x.n)
+	x.mtx.Unlock()
+
+	var body session.CreateResponseBody
+	body.SetID(id)
+	body.SetSessionKey(elliptic.MarshalCompressed(x.key.Curve, x.key.X, x.key.Y))
+
+	var resp session.CreateResponse
+	resp.SetBody(&body)
+	resp.SetMetaHeader(new(session.ResponseMetaHeader))
+
+	return &resp, signature.SignServiceMessage(x.key, &resp)
+}
+
+func TestSessionManager(t *testing.T) {
+	nodeKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ownerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	srv := &testSessionServer{t: t, key: nodeKey}
+
+	m, err := NewSessionManager(WithSessionLifetime(10), WithSessionRenewal(2))
+	require.NoError(t, err)
+	m.create = srv.create
+
+	target := SessionTarget{
+		Endpoint:     "localhost:8080",
+		Key:          ownerKey,
+		CurrentEpoch: 5,
+	}
+
+	var cnr refs.ContainerID
+	cnr.SetValue([]byte{1})
+
+	var obj refs.ObjectID
+	obj.SetValue([]byte{2})
+
+	tok, err := m.ObjectToken(target, session.ObjectVerbGet, &cnr, obj)
+	require.NoError(t, err)
+
+	body := tok.GetBody()
+	require.Equal(t, []byte{0, 0, 0, 1}, body.GetID())
+	require.Equal(t, elliptic.MarshalCompressed(nodeKey.Curve, nodeKey.X, nodeKey.Y), body.GetSessionKey())
+	require.EqualValues(t, 5, body.GetLifetime().GetIat())
+	require.EqualValues(t, 5, body.GetLifetime().GetNbf())
+	require.EqualValues(t, 15, body.GetLifetime().GetExp())
+	require.Len(t, body.GetOwnerID().GetValue(), 25)
+
+	c, ok := body.GetContext().(*session.ObjectSessionContext)
+	require.True(t, ok)
+	require.Equal(t, session.ObjectVerbGet, c.GetVerb())
+	require.Equal(t, &cnr, c.GetContainer())
+	require.Equal(t, []refs.ObjectID{obj}, c.GetObjects())
+
+	require.NoError(t, utilsig.VerifyDataWithSource(&signature.StableMarshalerWrapper{SM: body}, tok.GetSignature))
+
+	// cached session
+	target.CurrentEpoch = 12
+
+	tok, err = m.ContainerToken(target, session.ContainerVerbDelete, nil)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 1}, tok.GetBody().GetID())
+	require.EqualValues(t, 12, tok.GetBody().GetLifetime().GetIat())
+	require.EqualValues(t, 15, tok.GetBody().GetLifetime().GetExp())
+
+	cc, ok := tok.GetBody().GetContext().(*session.ContainerSessionContext)
+	require.True(t, ok)
+	require.Equal(t, session.ContainerVerbDelete, cc.Verb())
+	require.True(t, cc.Wildcard())
+
+	// renewal
+	target.CurrentEpoch = 13
+
+	tok, err = m.ContainerToken(target, session.ContainerVerbSetEACL, &cnr)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 2}, tok.GetBody().GetID())
+	require.EqualValues(t, 23, tok.GetBody().GetLifetime().GetExp())
+
+	// other endpoint and owner
+	target.Endpoint = "localhost:8081"
+
+	tok, err = m.ObjectToken(target, session.ObjectVerbPut, &cnr)
+	require.NoError(t, err)
+	require.Equal(t, []byte{0, 0, 0, 3}, tok.GetBody().GetID())
+
+	target.Key = otherKey
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			tok, err := m.ObjectToken(target, session.ObjectVerbPut, &cnr)
+			require.NoError(t, err)
+			require.Equal(t, []byte{0, 0, 0, 4}, tok.GetBody().GetID())
+		}()
+	}
+
+	wg.Wait()
+
+	target.Key = nil
+
+	_, err = m.ObjectToken(target, session.ObjectVerbPut, &cnr)
+	require.Error(t, err)
+
+	// expired sessions are dropped
+	target.Key = ownerKey
+	target.CurrentEpoch = 24
+
+	_, err = m.ObjectToken(target, session.ObjectVerbPut, &cnr)
+	require.NoError(t, err)
+	require.Len(t, m.sessions, 1)
+}
+
+func TestNewSessionManager(t *testing.T) {
+	_, err := NewSessionManager()
+	require.NoError(t, err)
+
+	_, err = NewSessionManager(WithSessionLifetime(5), WithSessionRenewal(4))
+	require.NoError(t, err)
+
+	_, err = NewSessionManager(WithSessionLifetime(5), WithSessionRenewal(5))
+	require.Error(t, err)
+
+	_, err = NewSessionManager(WithSessionLifetime(1))
+	require.Error(t, err)
+}
@@ -0,0 +1,125 @@
+package session
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+)
+
+var (
+	// ErrContainerSessionAmbiguous is returned by
+	// ContainerSessionContext.Validate when both wildcard flag and container
+	// ID are set.
+	ErrContainerSessionAmbiguous = errors.New("both wildcard flag and container ID are set")
+
+	// ErrContainerSessionNoTarget is returned by
+	// ContainerSessionContext.Validate when neither wildcard flag nor
+	// container ID are set.
+	ErrContainerSessionNoTarget = errors.New("neither wildcard flag nor container ID are set")
+)
+
+// Validate checks if the container session context is consistent: it must
+// either be wildcard or have container ID.
+func (x *ContainerSessionContext) Validate() error {
+	switch cnr := x.ContainerID(); {
+	case x.Wildcard() && cnr != nil:
+		return ErrContainerSessionAmbiguous
+	case !x.Wildcard() && cnr == nil:
+		return ErrContainerSessionNoTarget
+	default:
+		return nil
+	}
+}
+
+// Covers checks if the container session context covers the container, i.e.
+// it is wildcard or for this container.
+func (x *ContainerSessionContext) Covers(cnr *refs.ContainerID) bool {
+	if x.Wildcard() {
+		return true
+	}
+
+	id := x.ContainerID()
+
+	return id != nil && cnr != nil && bytes.Equal(id.GetValue(), cnr.GetValue())
+}
+
+// CoveredContainers returns containers from the given list covered by the
+// container session context in their original order.
+func (x *ContainerSessionContext) CoveredContainers(cnrs []refs.ContainerID) []refs.ContainerID {
+	var res []refs.ContainerID
+
+	for i := range cnrs {
+		if x.Covers(&cnrs[i]) {
+			res = append(res, cnrs[i])
+		}
+	}
+
+	return res
+}
+
+// ContainerOperation describes container operation performed within the
+// session.
+type ContainerOperation struct {
+	// Verb of the operation.
+	Verb ContainerSessionVerb
+
+	// Container is the subject of the operation. Must be nil for
+	// ContainerVerbPut since the container ID is not known before creation.
+	Container *refs.ContainerID
+}
+
+// ContainerSessionPlan is a container session context covering the particular
+// container operations.
+type ContainerSessionPlan struct {
+	// Context of the session token.
+	Context ContainerSessionContext
+
+	// Operations lists indices of the covered operations.
+	Operations []int
+}
+
+// PlanContainerSessions returns the narrowest set of container session
+// contexts covering all the operations: one context per distinct (verb,
+// container) pair. Operations without container are covered by wildcard
+// contexts. Plans are ordered by the first covered operation.
+func PlanContainerSessions(ops []ContainerOperation) []ContainerSessionPlan {
+	type planKey struct {
+		verb ContainerSessionVerb
+		cnr  string
+		all  bool
+	}
+
+	var (
+		res   []ContainerSessionPlan
+		index = make(map[planKey]int)
+	)
+
+	for i := range ops {
+		key := planKey{
+			verb: ops[i].Verb,
+			all:  ops[i].Container == nil,
+		}
+
+		if !key.all {
+			key.cnr = string(ops[i].Container.GetValue())
+		}
+
+		n, ok := index[key]
+		if !ok {
+			n = len(res)
+			index[key] = n
+
+			var c ContainerSessionContext
+			c.SetVerb(ops[i].Verb)
+			c.SetWildcard(key.all)
+			c.SetContainerID(ops[i].Container)
+
+			res = append(res, ContainerSessionPlan{Context: c})
+		}
+
+		res[n].Operations = append(res[n].Operations, i)
+	}
+
+	return res
+}
@@ -0,0 +1,75 @@
+package session_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/stretchr/testify/require"
+)
+
+func containerIDs(vals ...byte) []refs.ContainerID {
+	res := make([]refs.ContainerID, len(vals))
+	for i := range res {
+		res[i].SetValue([]byte{vals[i]})
+	}
+
+	return res
+}
+
+func TestContainerSessionContext_Covers(t *testing.T) {
+	cnrs := containerIDs(1, 2, 3)
+
+	var c session.ContainerSessionContext
+	require.ErrorIs(t, c.Validate(), session.ErrContainerSessionNoTarget)
+	require.Empty(t, c.CoveredContainers(cnrs))
+
+	c.SetContainerID(&cnrs[1])
+	require.NoError(t, c.Validate())
+	require.True(t, c.Covers(&cnrs[1]))
+	require.False(t, c.Covers(&cnrs[0]))
+	require.False(t, c.Covers(nil))
+	require.Equal(t, cnrs[1:2], c.CoveredContainers(cnrs))
+
+	c.SetWildcard(true)
+	require.ErrorIs(t, c.Validate(), session.ErrContainerSessionAmbiguous)
+
+	c.SetContainerID(nil)
+	require.NoError(t, c.Validate())
+	require.Equal(t, cnrs, c.CoveredContainers(cnrs))
+}
+
+func TestPlanContainerSessions(t *testing.T) {
+	cnrs := containerIDs(1, 2, 1)
+
+	plans := session.PlanContainerSessions([]session.ContainerOperation{
+		{Verb: session.ContainerVerbDelete, Container: &cnrs[0]},
+		{Verb: session.ContainerVerbPut},
+		{Verb: session.ContainerVerbSetEACL, Container: &cnrs[0]},
+		{Verb: session.ContainerVerbDelete, Container: &cnrs[1]},
+		{Verb: session.ContainerVerbDelete, Container: &cnrs[2]},
+		{Verb: session.ContainerVerbPut},
+	})
+	require.Len(t, plans, 4)
+
+	for i, tc := range []struct {
+		verb session.ContainerSessionVerb
+		cnr  *refs.ContainerID
+		ops  []int
+	}{
+		{verb: session.ContainerVerbDelete, cnr: &cnrs[0], ops: []int{0, 4}},
+		{verb: session.ContainerVerbPut, ops: []int{1, 5}},
+		{verb: session.ContainerVerbSetEACL, cnr: &cnrs[0], ops: []int{2}},
+		{verb: session.ContainerVerbDelete, cnr: &cnrs[1], ops: []int{3}},
+	} {
+		c := plans[i].Context
+
+		require.NoError(t, c.Validate())
+		require.Equal(t, tc.verb, c.Verb())
+		require.Equal(t, tc.cnr, c.ContainerID())
+		require.Equal(t, tc.cnr == nil, c.Wildcard())
+		require.Equal(t, tc.ops, plans[i].Operations)
+	}
+
+	require.Empty(t, session.PlanContainerSessions(nil))
+}
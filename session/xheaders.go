@@ -1,5 +1,13 @@
 package session
 
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/acl"
+)
+
 // ReservedXHeaderPrefix is a prefix of keys to "well-known" X-headers.
 const ReservedXHeaderPrefix = "__NEOFS__"
 
@@ -14,3 +22,160 @@ const (
 	// set, the current epoch only will be used.
 	XHeaderNetmapLookupDepth = ReservedXHeaderPrefix + "NETMAP_LOOKUP_DEPTH"
 )
+
+// EACLRequestHeaders returns X-headers of the original request meta header
+// (the deepest in the origin chain) as headers of acl.HeaderTypeRequest for
+// the eACL evaluation (see acl.Evaluate).
+func EACLRequestHeaders(meta *RequestMetaHeader) []acl.Header {
+	for meta.GetOrigin() != nil {
+		meta = meta.GetOrigin()
+	}
+
+	xs := meta.GetXHeaders()
+	res := make([]acl.Header, len(xs))
+
+	for i := range xs {
+		res[i] = &xs[i]
+	}
+
+	return res
+}
+
+// IsReservedXHeader checks if the key is a key to the reserved X-header, i.e.
+// has ReservedXHeaderPrefix.
+func IsReservedXHeader(key string) bool {
+	return strings.HasPrefix(key, ReservedXHeaderPrefix)
+}
+
+// NetmapEpoch returns value of the XHeaderNetmapEpoch X-header. Returns
+// zero if the X-header is not set. Returns an error if the value is not a
+// base-10 uint64 or the X-header is set more than once.
+func (r *RequestMetaHeader) NetmapEpoch() (uint64, error) {
+	return r.getUintXHeader(XHeaderNetmapEpoch)
+}
+
+// SetNetmapEpoch sets value of the XHeaderNetmapEpoch X-header replacing
+// all existing ones.
+func (r *RequestMetaHeader) SetNetmapEpoch(v uint64) {
+	r.setXHeader(XHeaderNetmapEpoch, strconv.FormatUint(v, 10))
+}
+
+// NetmapLookupDepth returns value of the XHeaderNetmapLookupDepth X-header.
+// Returns zero if the X-header is not set. Returns an error if the value is
+// not a base-10 uint64 or the X-header is set more than once.
+func (r *RequestMetaHeader) NetmapLookupDepth() (uint64, error) {
+	return r.getUintXHeader(XHeaderNetmapLookupDepth)
+}
+
+// SetNetmapLookupDepth sets value of the XHeaderNetmapLookupDepth X-header
+// replacing all existing ones.
+func (r *RequestMetaHeader) SetNetmapLookupDepth(v uint64) {
+	r.setXHeader(XHeaderNetmapLookupDepth, strconv.FormatUint(v, 10))
+}
+
+func (r *RequestMetaHeader) getUintXHeader(key string) (uint64, error) {
+	var (
+		res   uint64
+		found bool
+	)
+
+	xs := r.GetXHeaders()
+
+	for i := range xs {
+		if xs[i].GetKey() != key {
+			continue
+		}
+
+		if found {
+			return 0, fmt.Errorf("duplicated X-header %s", key)
+		}
+
+		var err error
+
+		res, err = strconv.ParseUint(xs[i].GetValue(), 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid X-header %s: %w", key, err)
+		}
+
+		found = true
+	}
+
+	return res, nil
+}
+
+func (r *RequestMetaHeader) setXHeader(key, val string) {
+	xs := r.GetXHeaders()
+	res := make([]XHeader, 0, len(xs)+1)
+
+	for i := range xs {
+		if xs[i].GetKey() != key {
+			res = append(res, xs[i])
+		}
+	}
+
+	var x XHeader
+	x.SetKey(key)
+	x.SetValue(val)
+
+	r.SetXHeaders(append(res, x))
+}
+
+// ValidateXHeaders checks X-headers of the meta header: keys must be unique,
+// values of the known reserved X-headers (XHeaderNetmapEpoch and
+// XHeaderNetmapLookupDepth) must be base-10 uint64. Origin meta headers are
+// not checked.
+func (r *RequestMetaHeader) ValidateXHeaders() error {
+	xs := r.GetXHeaders()
+	seen := make(map[string]struct{}, len(xs))
+
+	for i := range xs {
+		key := xs[i].GetKey()
+
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicated X-header %s", key)
+		}
+
+		seen[key] = struct{}{}
+
+		switch key {
+		case XHeaderNetmapEpoch, XHeaderNetmapLookupDepth:
+			if _, err := strconv.ParseUint(xs[i].GetValue(), 10, 64); err != nil {
+				return fmt.Errorf("invalid X-header %s: %w", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReservedXHeaders returns reserved X-headers of the meta header (see
+// IsReservedXHeader) in their original order. Origin meta headers are not
+// processed.
+func (r *RequestMetaHeader) ReservedXHeaders() []XHeader {
+	var res []XHeader
+
+	for _, x := range r.GetXHeaders() {
+		if IsReservedXHeader(x.GetKey()) {
+			res = append(res, x)
+		}
+	}
+
+	return res
+}
+
+// StripReservedXHeaders removes reserved X-headers (see IsReservedXHeader)
+// from the meta header, e.g. when the request is forwarded across the trust
+// boundary. Origin meta headers are not processed since they are signed by
+// the previous senders.
+func (r *RequestMetaHeader) StripReservedXHeaders() {
+	xs := r.GetXHeaders()
+	res := make([]XHeader, 0, len(xs))
+
+	for i := range xs {
+		if !IsReservedXHeader(xs[i].GetKey()) {
+			res = append(res, xs[i])
+		}
+	}
+
+	r.SetXHeaders(res)
+}
@@ -0,0 +1,70 @@
+package session_test
+
+import (
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/stretchr/testify/require"
+)
+
+func xHeaders(kvs ...string) []session.XHeader {
+	res := make([]session.XHeader, len(kvs)/2)
+
+	for i := range res {
+		res[i].SetKey(kvs[2*i])
+		res[i].SetValue(kvs[2*i+1])
+	}
+
+	return res
+}
+
+func TestRequestMetaHeader_ReservedXHeaders(t *testing.T) {
+	var meta session.RequestMetaHeader
+
+	epoch, err := meta.NetmapEpoch()
+	require.NoError(t, err)
+	require.Zero(t, epoch)
+
+	meta.SetXHeaders(xHeaders("key", "val", session.XHeaderNetmapEpoch, "1", session.XHeaderNetmapEpoch, "2"))
+
+	_, err = meta.NetmapEpoch()
+	require.Error(t, err)
+	require.Error(t, meta.ValidateXHeaders())
+
+	meta.SetNetmapEpoch(10)
+	meta.SetNetmapLookupDepth(3)
+
+	require.Equal(t, xHeaders("key", "val", session.XHeaderNetmapEpoch, "10", session.XHeaderNetmapLookupDepth, "3"), meta.GetXHeaders())
+	require.NoError(t, meta.ValidateXHeaders())
+
+	epoch, err = meta.NetmapEpoch()
+	require.NoError(t, err)
+	require.EqualValues(t, 10, epoch)
+
+	depth, err := meta.NetmapLookupDepth()
+	require.NoError(t, err)
+	require.EqualValues(t, 3, depth)
+
+	require.Equal(t, xHeaders(session.XHeaderNetmapEpoch, "10", session.XHeaderNetmapLookupDepth, "3"), meta.ReservedXHeaders())
+
+	meta.StripReservedXHeaders()
+	require.Equal(t, xHeaders("key", "val"), meta.GetXHeaders())
+	require.Empty(t, meta.ReservedXHeaders())
+
+	for _, xs := range [][]session.XHeader{
+		xHeaders(session.XHeaderNetmapLookupDepth, "-1"),
+		xHeaders(session.XHeaderNetmapEpoch, ""),
+		xHeaders("key", "1", "key", "2"),
+	} {
+		meta.SetXHeaders(xs)
+		require.Error(t, meta.ValidateXHeaders())
+	}
+
+	meta.SetXHeaders(xHeaders(session.XHeaderNetmapLookupDepth, "one"))
+
+	_, err = meta.NetmapLookupDepth()
+	require.Error(t, err)
+
+	require.True(t, session.IsReservedXHeader(session.ReservedXHeaderPrefix+"ANY"))
+	require.False(t, session.IsReservedXHeader("X-Tenant"))
+}
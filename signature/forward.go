@@ -0,0 +1,78 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+)
+
+// ErrTTLExhausted is returned by ForwardRequest when the request can not be
+// forwarded anymore because its TTL would reach zero.
+var ErrTTLExhausted = errors.New("request TTL exhausted")
+
+// ForwardRequest prepares the received request for forwarding by the relay:
+//   - new meta header with the TTL decremented by one wraps the received
+//     meta header as origin, version, epoch and network magic are inherited;
+//   - new verification header with meta and origin signatures made by the
+//     relay key wraps the received verification header as origin.
+//
+// Request must be one of the requests supported by SignServiceMessage.
+// Returns ErrTTLExhausted if the request TTL is 1 or less.
+func ForwardRequest(key *ecdsa.PrivateKey, req any) error {
+	r, ok := req.(interface {
+		serviceRequest
+		SetMetaHeader(*session.RequestMetaHeader)
+	})
+	if !ok {
+		return fmt.Errorf("unsupported request %T", req)
+	}
+
+	origin := r.GetMetaHeader()
+
+	ttl := origin.GetTTL()
+	if ttl <= 1 {
+		return ErrTTLExhausted
+	}
+
+	var meta session.RequestMetaHeader
+	meta.SetVersion(origin.GetVersion())
+	meta.SetTTL(ttl - 1)
+	meta.SetEpoch(origin.GetEpoch())
+	meta.SetNetworkMagic(origin.GetNetworkMagic())
+	meta.SetOrigin(origin)
+
+	r.SetMetaHeader(&meta)
+
+	return SignServiceMessage(key, req)
+}
+
+// VerifyRequestChain verifies the request like VerifyServiceMessage and
+// returns public keys of all its signers starting from the original sender
+// followed by the relays in the forwarding order.
+//
+// Request must be one of the requests supported by VerifyServiceMessage.
+func VerifyRequestChain(req any) ([][]byte, error) {
+	r, ok := req.(serviceRequest)
+	if !ok {
+		return nil, fmt.Errorf("unsupported request %T", req)
+	}
+
+	err := VerifyServiceMessage(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var res [][]byte
+
+	for h := r.GetVerificationHeader(); h != nil; h = h.GetOrigin() {
+		res = append(res, h.GetMetaSignature().GetKey())
+	}
+
+	for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+		res[i], res[j] = res[j], res[i]
+	}
+
+	return res, nil
+}
@@ -0,0 +1,83 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardRequest(t *testing.T) {
+	var keys [3]*ecdsa.PrivateKey
+	var pubs [3][]byte
+
+	for i := range keys {
+		var err error
+
+		keys[i], err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+
+		pubs[i] = elliptic.MarshalCompressed(keys[i].Curve, keys[i].X, keys[i].Y)
+	}
+
+	var cnr refs.ContainerID
+	cnr.SetValue([]byte{1})
+
+	var body container.GetRequestBody
+	body.SetContainerID(&cnr)
+
+	var xs [1]session.XHeader
+	xs[0].SetKey("key")
+	xs[0].SetValue("value")
+
+	var meta session.RequestMetaHeader
+	meta.SetTTL(3)
+	meta.SetEpoch(10)
+	meta.SetNetworkMagic(20)
+	meta.SetXHeaders(xs[:])
+
+	var req container.GetRequest
+	req.SetBody(&body)
+	req.SetMetaHeader(&meta)
+
+	require.NoError(t, SignServiceMessage(keys[0], &req))
+
+	signers, err := VerifyRequestChain(&req)
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{pubs[0]}, signers)
+
+	require.NoError(t, ForwardRequest(keys[1], &req))
+
+	relayed := req.GetMetaHeader()
+	require.Equal(t, &meta, relayed.GetOrigin())
+	require.EqualValues(t, 2, relayed.GetTTL())
+	require.EqualValues(t, 10, relayed.GetEpoch())
+	require.EqualValues(t, 20, relayed.GetNetworkMagic())
+	require.Empty(t, relayed.GetXHeaders())
+
+	require.NoError(t, ForwardRequest(keys[2], &req))
+	require.EqualValues(t, 1, req.GetMetaHeader().GetTTL())
+
+	signers, err = VerifyRequestChain(&req)
+	require.NoError(t, err)
+	require.Equal(t, pubs[:], signers)
+
+	require.True(t, errors.Is(ForwardRequest(keys[0], &req), ErrTTLExhausted))
+
+	// corrupt the original request
+	meta.SetEpoch(11)
+
+	_, err = VerifyRequestChain(&req)
+	require.Error(t, err)
+
+	require.Error(t, ForwardRequest(keys[0], new(container.GetResponse)))
+
+	_, err = VerifyRequestChain(new(container.GetResponse))
+	require.Error(t, err)
+}
@@ -0,0 +1,281 @@
+package signature
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+)
+
+// SessionTokenErrorKind enumerates reasons why the session token does not
+// authorize the request.
+type SessionTokenErrorKind uint8
+
+const (
+	_ SessionTokenErrorKind = iota
+
+	// SessionTokenUnsupportedRequest means that the request can not be
+	// performed within a session.
+	SessionTokenUnsupportedRequest
+
+	// SessionTokenMissingBody means that the token has no body.
+	SessionTokenMissingBody
+
+	// SessionTokenInvalidSignature means that the token body signature is
+	// missing or incorrect.
+	SessionTokenInvalidSignature
+
+	// SessionTokenOwnerMismatch means that the token owner does not
+	// correspond to the key the token is signed with.
+	SessionTokenOwnerMismatch
+
+	// SessionTokenExpired means that the token expiration epoch is before the
+	// current one.
+	SessionTokenExpired
+
+	// SessionTokenNotYetValid means that the token validity period starts
+	// after the current epoch.
+	SessionTokenNotYetValid
+
+	// SessionTokenIssuedInFuture means that the token is issued after the
+	// current epoch.
+	SessionTokenIssuedInFuture
+
+	// SessionTokenWrongVerb means that the token context is not for the
+	// requested operation.
+	SessionTokenWrongVerb
+
+	// SessionTokenContainerNotCovered means that the token context does not
+	// cover the requested container.
+	SessionTokenContainerNotCovered
+
+	// SessionTokenObjectNotCovered means that the token context does not
+	// cover the requested object.
+	SessionTokenObjectNotCovered
+
+	// SessionTokenKeyMismatch means that the request body is signed not by
+	// the session key.
+	SessionTokenKeyMismatch
+)
+
+// SessionTokenError describes why the session token does not authorize the
+// request.
+type SessionTokenError struct {
+	// Kind of the problem.
+	Kind SessionTokenErrorKind
+
+	// Reason explains the problem.
+	Reason string
+}
+
+func (x *SessionTokenError) Error() string {
+	return "session token: " + x.Reason
+}
+
+func sessionTokenErrorf(kind SessionTokenErrorKind, format string, args ...any) error {
+	return &SessionTokenError{
+		Kind:   kind,
+		Reason: fmt.Sprintf(format, args...),
+	}
+}
+
+// compatibleObjectVerbs lists object session verbs authorizing the requested
+// operation in addition to its own verb the same way storage nodes do: DELETE
+// includes PUT and SEARCH of the tombstone, HEAD is a part of any reading.
+var compatibleObjectVerbs = map[session.ObjectSessionVerb][]session.ObjectSessionVerb{
+	session.ObjectVerbPut:    {session.ObjectVerbDelete},
+	session.ObjectVerbHead:   {session.ObjectVerbGet, session.ObjectVerbDelete, session.ObjectVerbRange, session.ObjectVerbRangeHash},
+	session.ObjectVerbSearch: {session.ObjectVerbDelete},
+	session.ObjectVerbRange:  {session.ObjectVerbRangeHash},
+}
+
+// sessionRequest describes request performed within the session.
+type sessionRequest struct {
+	objectVerb    session.ObjectSessionVerb
+	containerVerb session.ContainerSessionVerb
+
+	// nil if not known
+	cnr *refs.ContainerID
+	obj *refs.ObjectID
+}
+
+// CheckSessionToken checks whether the session token authorizes the request
+// at the given epoch. The token authorizes the request if:
+//   - its body is signed by the owner, i.e. owner ID derived from the
+//     signature key is the token owner;
+//   - the epoch is within the token lifetime: not before the issue and the
+//     validity start, not after the expiration;
+//   - its context is for the requested operation: session.ObjectSessionContext
+//     for object requests, session.ContainerSessionContext for container ones;
+//     object context verb is the requested one or compatible with it: HEAD
+//     is authorized by GET, DELETE, RANGE and RANGEHASH, PUT and SEARCH are
+//     authorized by DELETE, RANGE is authorized by RANGEHASH;
+//   - its context covers the requested container and object (if any): object
+//     context covers all objects of the container if no objects are listed,
+//     container context covers all containers of the owner if it is wildcard;
+//   - the request body (the most nested level of the verification header) is
+//     signed with the session key.
+//
+// Supported requests are object.PutRequest, object.GetRequest,
+// object.HeadRequest, object.SearchRequest, object.DeleteRequest,
+// object.GetRangeRequest, object.GetRangeHashRequest, container.PutRequest,
+// container.DeleteRequest and container.SetExtendedACLRequest. Target container
+// and object are not checked for object.PutRequest with the payload chunk,
+// container is not checked for container.PutRequest.
+//
+// Returns *SessionTokenError if the token does not authorize the request.
+func CheckSessionToken(tok *session.Token, req any, epoch uint64) error {
+	var sr sessionRequest
+
+	switch v := req.(type) {
+	default:
+		return sessionTokenErrorf(SessionTokenUnsupportedRequest, "unsupported request %T", req)
+	case *object.PutRequest:
+		sr.objectVerb = session.ObjectVerbPut
+
+		if init, ok := v.GetBody().GetObjectPart().(*object.PutObjectPartInit); ok {
+			sr.cnr = init.GetHeader().GetContainerID()
+			sr.obj = init.GetObjectID()
+		}
+	case *object.GetRequest:
+		sr.objectVerb = session.ObjectVerbGet
+		sr.setAddress(v.GetBody().GetAddress())
+	case *object.HeadRequest:
+		sr.objectVerb = session.ObjectVerbHead
+		sr.setAddress(v.GetBody().GetAddress())
+	case *object.SearchRequest:
+		sr.objectVerb = session.ObjectVerbSearch
+		sr.cnr = v.GetBody().GetContainerID()
+	case *object.DeleteRequest:
+		sr.objectVerb = session.ObjectVerbDelete
+		sr.setAddress(v.GetBody().GetAddress())
+	case *object.GetRangeRequest:
+		sr.objectVerb = session.ObjectVerbRange
+		sr.setAddress(v.GetBody().GetAddress())
+	case *object.GetRangeHashRequest:
+		sr.objectVerb = session.ObjectVerbRangeHash
+		sr.setAddress(v.GetBody().GetAddress())
+	case *container.PutRequest:
+		sr.containerVerb = session.ContainerVerbPut
+	case *container.DeleteRequest:
+		sr.containerVerb = session.ContainerVerbDelete
+		sr.cnr = v.GetBody().GetContainerID()
+	case *container.SetExtendedACLRequest:
+		sr.containerVerb = session.ContainerVerbSetEACL
+		sr.cnr = v.GetBody().GetEACL().GetContainerID()
+	}
+
+	body := tok.GetBody()
+	if body == nil {
+		return sessionTokenErrorf(SessionTokenMissingBody, "missing body")
+	}
+
+	sig := tok.GetSignature()
+
+	err := signature.VerifyDataWithSource(&StableMarshalerWrapper{body}, func() *refs.Signature { return sig })
+	if err != nil {
+		return sessionTokenErrorf(SessionTokenInvalidSignature, "invalid body signature: %v", err)
+	}
+
+	if !bytes.Equal(body.GetOwnerID().GetValue(), ownerid.FromPublicKey(sig.GetKey())) {
+		return sessionTokenErrorf(SessionTokenOwnerMismatch, "owner does not correspond to the signature key")
+	}
+
+	lt := body.GetLifetime()
+
+	if exp := lt.GetExp(); epoch > exp {
+		return sessionTokenErrorf(SessionTokenExpired, "expired at epoch %d, current is %d", exp, epoch)
+	}
+
+	if nbf := lt.GetNbf(); epoch < nbf {
+		return sessionTokenErrorf(SessionTokenNotYetValid, "valid since epoch %d, current is %d", nbf, epoch)
+	}
+
+	if iat := lt.GetIat(); epoch < iat {
+		return sessionTokenErrorf(SessionTokenIssuedInFuture, "issued at future epoch %d, current is %d", iat, epoch)
+	}
+
+	err = checkSessionContext(body.GetContext(), &sr)
+	if err != nil {
+		return err
+	}
+
+	verifyHdr := req.(serviceRequest).GetVerificationHeader()
+	for verifyHdr.GetOrigin() != nil {
+		verifyHdr = verifyHdr.GetOrigin()
+	}
+
+	if !bytes.Equal(verifyHdr.GetBodySignature().GetKey(), body.GetSessionKey()) {
+		return sessionTokenErrorf(SessionTokenKeyMismatch, "request body is not signed with the session key")
+	}
+
+	return nil
+}
+
+func (x *sessionRequest) setAddress(addr *refs.Address) {
+	x.cnr = addr.GetContainerID()
+	x.obj = addr.GetObjectID()
+}
+
+func checkSessionContext(c session.TokenContext, sr *sessionRequest) error {
+	switch v := c.(type) {
+	default:
+		return sessionTokenErrorf(SessionTokenWrongVerb, "unsupported context %T", c)
+	case *session.ObjectSessionContext:
+		if sr.objectVerb == session.ObjectVerbUnknown || !objectVerbAuthorizes(v.GetVerb(), sr.objectVerb) {
+			return sessionTokenErrorf(SessionTokenWrongVerb, "object context verb %d does not match the request", v.GetVerb())
+		}
+
+		if sr.cnr == nil {
+			return nil
+		}
+
+		if !bytes.Equal(v.GetContainer().GetValue(), sr.cnr.GetValue()) {
+			return sessionTokenErrorf(SessionTokenContainerNotCovered, "requested container is not covered")
+		}
+
+		objs := v.GetObjects()
+		if sr.obj == nil || len(objs) == 0 {
+			return nil
+		}
+
+		for i := range objs {
+			if bytes.Equal(objs[i].GetValue(), sr.obj.GetValue()) {
+				return nil
+			}
+		}
+
+		return sessionTokenErrorf(SessionTokenObjectNotCovered, "requested object is not covered")
+	case *session.ContainerSessionContext:
+		if sr.containerVerb == session.ContainerVerbUnknown || v.Verb() != sr.containerVerb {
+			return sessionTokenErrorf(SessionTokenWrongVerb, "container context verb %d does not match the request", v.Verb())
+		}
+
+		if sr.cnr != nil && !v.Covers(sr.cnr) {
+			return sessionTokenErrorf(SessionTokenContainerNotCovered, "requested container is not covered")
+		}
+
+		return nil
+	}
+}
+
+// objectVerbAuthorizes checks whether the object session token verb
+// authorizes the requested operation (see compatibleObjectVerbs).
+func objectVerbAuthorizes(tokenVerb, reqVerb session.ObjectSessionVerb) bool {
+	if tokenVerb == reqVerb {
+		return true
+	}
+
+	for _, v := range compatibleObjectVerbs[reqVerb] {
+		if v == tokenVerb {
+			return true
+		}
+	}
+
+	return false
+}
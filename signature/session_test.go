@@ -0,0 +1,259 @@
+package signature
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/nspcc-dev/neofs-api-go/v2/container"
+	"github.com/nspcc-dev/neofs-api-go/v2/internal/ownerid"
+	"github.com/nspcc-dev/neofs-api-go/v2/object"
+	"github.com/nspcc-dev/neofs-api-go/v2/refs"
+	"github.com/nspcc-dev/neofs-api-go/v2/session"
+	"github.com/nspcc-dev/neofs-api-go/v2/util/signature"
+	"github.com/stretchr/testify/require"
+)
+
+func requireSessionTokenError(t *testing.T, kind SessionTokenErrorKind, err error) {
+	var e *SessionTokenError
+	require.True(t, errors.As(err, &e), err)
+	require.Equal(t, kind, e.Kind, err)
+}
+
+func TestCheckSessionToken(t *testing.T) {
+	ownerKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	sessionKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	var cnr, otherCnr refs.ContainerID
+	cnr.SetValue([]byte{1})
+	otherCnr.SetValue([]byte{2})
+
+	var obj, otherObj refs.ObjectID
+	obj.SetValue([]byte{3})
+	otherObj.SetValue([]byte{4})
+
+	var owner refs.OwnerID
+	owner.SetValue(ownerid.FromPublicKey(elliptic.MarshalCompressed(ownerKey.Curve, ownerKey.X, ownerKey.Y)))
+
+	var lt session.TokenLifetime
+	lt.SetIat(10)
+	lt.SetNbf(11)
+	lt.SetExp(20)
+
+	newToken := func(c session.TokenContext) *session.Token {
+		var body session.TokenBody
+		body.SetOwnerID(&owner)
+		body.SetLifetime(&lt)
+		body.SetSessionKey(elliptic.MarshalCompressed(sessionKey.Curve, sessionKey.X, sessionKey.Y))
+		body.SetContext(c)
+
+		var tok session.Token
+		tok.SetBody(&body)
+
+		require.NoError(t, signature.SignDataWithHandler(ownerKey, &StableMarshalerWrapper{&body}, tok.SetSignature))
+
+		return &tok
+	}
+
+	var addr refs.Address
+	addr.SetContainerID(&cnr)
+	addr.SetObjectID(&obj)
+
+	var getBody object.GetRequestBody
+	getBody.SetAddress(&addr)
+
+	var getReq object.GetRequest
+	getReq.SetBody(&getBody)
+	getReq.SetMetaHeader(new(session.RequestMetaHeader))
+	require.NoError(t, SignServiceMessage(sessionKey, &getReq))
+
+	var objCtx session.ObjectSessionContext
+	objCtx.SetVerb(session.ObjectVerbGet)
+	objCtx.SetTarget(&cnr, otherObj, obj)
+
+	tok := newToken(&objCtx)
+
+	require.NoError(t, CheckSessionToken(tok, &getReq, 15))
+
+	t.Run("lifetime", func(t *testing.T) {
+		requireSessionTokenError(t, SessionTokenExpired, CheckSessionToken(tok, &getReq, 21))
+		requireSessionTokenError(t, SessionTokenNotYetValid, CheckSessionToken(tok, &getReq, 10))
+		require.NoError(t, CheckSessionToken(tok, &getReq, 11))
+	})
+
+	t.Run("signature", func(t *testing.T) {
+		requireSessionTokenError(t, SessionTokenMissingBody, CheckSessionToken(new(session.Token), &getReq, 15))
+
+		tok := newToken(&objCtx)
+		tok.GetBody().SetID([]byte{1})
+		requireSessionTokenError(t, SessionTokenInvalidSignature, CheckSessionToken(tok, &getReq, 15))
+
+		var other refs.OwnerID
+		other.SetValue([]byte{1})
+
+		tok.GetBody().SetOwnerID(&other)
+		require.NoError(t, signature.SignDataWithHandler(ownerKey, &StableMarshalerWrapper{tok.GetBody()}, tok.SetSignature))
+		requireSessionTokenError(t, SessionTokenOwnerMismatch, CheckSessionToken(tok, &getReq, 15))
+	})
+
+	t.Run("key", func(t *testing.T) {
+		var req object.GetRequest
+		req.SetBody(&getBody)
+		req.SetMetaHeader(new(session.RequestMetaHeader))
+		require.NoError(t, SignServiceMessage(ownerKey, &req))
+
+		requireSessionTokenError(t, SessionTokenKeyMismatch, CheckSessionToken(tok, &req, 15))
+
+		// relayed request is still signed by the session key at the deepest level
+		var meta session.RequestMetaHeader
+		meta.SetOrigin(getReq.GetMetaHeader())
+
+		req.SetMetaHeader(&meta)
+		req.SetVerificationHeader(getReq.GetVerificationHeader())
+		require.NoError(t, SignServiceMessage(ownerKey, &req))
+
+		require.NoError(t, CheckSessionToken(tok, &req, 15))
+	})
+
+	t.Run("object context", func(t *testing.T) {
+		var putReq object.PutRequest
+		requireSessionTokenError(t, SessionTokenWrongVerb, CheckSessionToken(tok, &putReq, 15))
+
+		var delReq container.DeleteRequest
+		requireSessionTokenError(t, SessionTokenWrongVerb, CheckSessionToken(tok, &delReq, 15))
+
+		addr.SetObjectID(&otherObj)
+		require.NoError(t, CheckSessionToken(tok, &getReq, 15))
+
+		var o refs.ObjectID
+		o.SetValue([]byte{5})
+
+		addr.SetObjectID(&o)
+		requireSessionTokenError(t, SessionTokenObjectNotCovered, CheckSessionToken(tok, &getReq, 15))
+
+		addr.SetContainerID(&otherCnr)
+		requireSessionTokenError(t, SessionTokenContainerNotCovered, CheckSessionToken(tok, &getReq, 15))
+
+		objCtx := objCtx
+		objCtx.SetTarget(&otherCnr)
+
+		require.NoError(t, CheckSessionToken(newToken(&objCtx), &getReq, 15))
+
+		addr.SetContainerID(&cnr)
+		addr.SetObjectID(&obj)
+	})
+
+	t.Run("object verbs", func(t *testing.T) {
+		var (
+			headBody      object.HeadRequestBody
+			searchBody    object.SearchRequestBody
+			deleteBody    object.DeleteRequestBody
+			rangeBody     object.GetRangeRequestBody
+			rangeHashBody object.GetRangeHashRequestBody
+
+			putReq       object.PutRequest
+			headReq      object.HeadRequest
+			searchReq    object.SearchRequest
+			deleteReq    object.DeleteRequest
+			rangeReq     object.GetRangeRequest
+			rangeHashReq object.GetRangeHashRequest
+		)
+
+		headBody.SetAddress(&addr)
+		searchBody.SetContainerID(&cnr)
+		deleteBody.SetAddress(&addr)
+		rangeBody.SetAddress(&addr)
+		rangeHashBody.SetAddress(&addr)
+
+		putReq.SetBody(new(object.PutRequestBody))
+		headReq.SetBody(&headBody)
+		searchReq.SetBody(&searchBody)
+		deleteReq.SetBody(&deleteBody)
+		rangeReq.SetBody(&rangeBody)
+		rangeHashReq.SetBody(&rangeHashBody)
+
+		reqs := map[session.ObjectSessionVerb]interface {
+			serviceRequest
+			SetMetaHeader(*session.RequestMetaHeader)
+		}{
+			session.ObjectVerbPut:       &putReq,
+			session.ObjectVerbGet:       &getReq,
+			session.ObjectVerbHead:      &headReq,
+			session.ObjectVerbSearch:    &searchReq,
+			session.ObjectVerbDelete:    &deleteReq,
+			session.ObjectVerbRange:     &rangeReq,
+			session.ObjectVerbRangeHash: &rangeHashReq,
+		}
+
+		for _, req := range reqs {
+			req.SetMetaHeader(new(session.RequestMetaHeader))
+			require.NoError(t, SignServiceMessage(sessionKey, req))
+		}
+
+		// token verb -> authorized request verbs
+		allowed := map[session.ObjectSessionVerb][]session.ObjectSessionVerb{
+			session.ObjectVerbPut:       {session.ObjectVerbPut},
+			session.ObjectVerbGet:       {session.ObjectVerbGet, session.ObjectVerbHead},
+			session.ObjectVerbHead:      {session.ObjectVerbHead},
+			session.ObjectVerbSearch:    {session.ObjectVerbSearch},
+			session.ObjectVerbDelete:    {session.ObjectVerbDelete, session.ObjectVerbHead, session.ObjectVerbPut, session.ObjectVerbSearch},
+			session.ObjectVerbRange:     {session.ObjectVerbRange, session.ObjectVerbHead},
+			session.ObjectVerbRangeHash: {session.ObjectVerbRangeHash, session.ObjectVerbHead, session.ObjectVerbRange},
+		}
+
+		for tokVerb, verbs := range allowed {
+			var c session.ObjectSessionContext
+			c.SetVerb(tokVerb)
+			c.SetTarget(&cnr)
+
+			tok := newToken(&c)
+
+			for reqVerb, req := range reqs {
+				var ok bool
+				for i := range verbs {
+					ok = ok || verbs[i] == reqVerb
+				}
+
+				err := CheckSessionToken(tok, req, 15)
+
+				if ok {
+					require.NoError(t, err, "token %v, request %v", tokVerb, reqVerb)
+				} else {
+					requireSessionTokenError(t, SessionTokenWrongVerb, err)
+				}
+			}
+		}
+	})
+
+	t.Run("container context", func(t *testing.T) {
+		var body container.DeleteRequestBody
+		body.SetContainerID(&cnr)
+
+		var req container.DeleteRequest
+		req.SetBody(&body)
+		req.SetMetaHeader(new(session.RequestMetaHeader))
+		require.NoError(t, SignServiceMessage(sessionKey, &req))
+
+		var c session.ContainerSessionContext
+		c.SetVerb(session.ContainerVerbDelete)
+		c.SetContainerID(&cnr)
+
+		require.NoError(t, CheckSessionToken(newToken(&c), &req, 15))
+
+		c.SetContainerID(&otherCnr)
+		requireSessionTokenError(t, SessionTokenContainerNotCovered, CheckSessionToken(newToken(&c), &req, 15))
+
+		c.SetWildcard(true)
+		require.NoError(t, CheckSessionToken(newToken(&c), &req, 15))
+
+		c.SetVerb(session.ContainerVerbSetEACL)
+		requireSessionTokenError(t, SessionTokenWrongVerb, CheckSessionToken(newToken(&c), &req, 15))
+	})
+
+	requireSessionTokenError(t, SessionTokenUnsupportedRequest, CheckSessionToken(tok, new(object.PutResponse), 15))
+}